@@ -1,16 +1,32 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata" // deadlines may name any time zone, even where the system has no zoneinfo
 
 	"github.com/NafisaTojiboyeva/todo-service/config"
 	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/outbox"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/auth"
 	"github.com/NafisaTojiboyeva/todo-service/pkg/db"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/deadline"
 	"github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/reminder"
 	"github.com/NafisaTojiboyeva/todo-service/service"
 	"github.com/NafisaTojiboyeva/todo-service/storage"
+	"github.com/NafisaTojiboyeva/todo-service/storage/postgres"
+	"github.com/NafisaTojiboyeva/todo-service/watch"
+	"github.com/NafisaTojiboyeva/todo-service/webhook"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -25,32 +41,164 @@ func main() {
 		}
 	}(log)
 
-	log.Info("main: sqlxConfig",
-		logger.String("host", cfg.PostgresHost),
-		logger.Int("port", cfg.PostgresPort),
-		logger.String("database", cfg.PostgresDatabase))
+	var taskStorage storage.IStorage
+	if cfg.Storage == "memory" {
+		log.Info("main: keeping tasks in memory, they are lost on exit")
+		taskStorage = storage.NewStorageMemory()
+	} else {
+		log.Info("main: sqlxConfig",
+			logger.String("host", cfg.PostgresHost),
+			logger.Int("port", cfg.PostgresPort),
+			logger.String("database", cfg.PostgresDatabase))
 
-	connDB, err := db.ConnectToDB(cfg)
+		connDB, err := db.ConnectToDB(cfg)
+		if err != nil {
+			log.Fatal("sqlx connection to postgres error", logger.Error(err))
+		}
+
+		taskStorage = storage.NewStoragePg(connDB)
+	}
+
+	deadlines, err := deadline.NewParser(cfg.DefaultTimeZone)
 	if err != nil {
-		log.Fatal("sqlx connection to postgres error", logger.Error(err))
+		log.Fatal("invalid DEFAULT_TIME_ZONE", logger.Error(err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dispatcher := webhook.NewDispatcher(taskStorage.Webhook(), log, webhook.Options{
+		MaxAttempts: cfg.WebhookMaxAttempts,
+		BaseDelay:   cfg.WebhookRetryDelay,
+		MaxDelay:    cfg.WebhookMaxDelay,
+	})
+
+	publishers := outbox.Publishers{dispatcher}
+	switch cfg.EventLog {
+	case "":
+	case "stdout":
+		publishers = append(publishers, outbox.NewWriterPublisher(os.Stdout))
+	default:
+		eventLog, err := os.OpenFile(cfg.EventLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Fatal("failed to open EVENT_LOG", logger.Error(err))
+		}
+		defer eventLog.Close() // nolint:errcheck
+		publishers = append(publishers, outbox.NewWriterPublisher(eventLog))
 	}
 
-	pgStorage := storage.NewStoragePg(connDB)
+	hub := watch.NewHub(taskStorage.Outbox(), log, watch.Options{})
+	go hub.Run(ctx)
+	if cfg.Storage != "memory" {
+		// Hear about the events the relays of other instances deliver too.
+		go func() {
+			if err := postgres.ListenRelayed(ctx, db.ConnString(cfg), hub.Wake); err != nil {
+				log.Error("failed to listen for relayed events, watchers poll instead", logger.Error(err))
+			}
+		}()
+	}
 
-	taskService := service.NewToDoService(pgStorage, log)
+	relay := outbox.NewRelay(taskStorage.Outbox(), publishers, log, outbox.Options{
+		Interval: cfg.OutboxInterval,
+		Lease:    cfg.OutboxLease,
+		Relayed:  hub.Wake,
+	})
+	go relay.Run(ctx)
+
+	taskService := service.NewToDoService(taskStorage, log, deadlines, hub)
+
+	if cfg.ReminderInterval > 0 {
+		notifiers := reminder.Notifiers{reminder.NewLogNotifier(log)}
+		if cfg.ReminderWebhookURL != "" {
+			notifiers = append(notifiers, reminder.NewWebhookNotifier(cfg.ReminderWebhookURL, nil))
+		}
+		scheduler := reminder.NewScheduler(taskStorage.Reminder(), notifiers, log, reminder.Options{
+			Interval:      cfg.ReminderInterval,
+			LeadTime:      cfg.ReminderLeadTime,
+			OverdueWindow: cfg.ReminderOverdue,
+			MaxAttempts:   cfg.ReminderAttempts,
+			RetryDelay:    cfg.ReminderRetryDelay,
+			MaxDelay:      cfg.ReminderMaxDelay,
+		})
+		go scheduler.Run(ctx)
+		log.Info("main: reminders on",
+			logger.Any("interval", cfg.ReminderInterval),
+			logger.Any("lead_time", cfg.ReminderLeadTime),
+			logger.Any("overdue_window", cfg.ReminderOverdue))
+	}
 
 	lis, err := net.Listen("tcp", cfg.RPCPort)
 	if err != nil {
 		log.Fatal("Error while listening: %v", logger.Error(err))
 	}
 
-	s := grpc.NewServer()
+	var opts []grpc.ServerOption
+	if cfg.AuthDisabled {
+		log.Warn("main: AUTH_DISABLED is set, every caller is served without credentials")
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(auth.DisabledUnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(auth.DisabledStreamServerInterceptor()))
+	} else {
+		authenticator, err := newAuthenticator(cfg)
+		if err != nil {
+			log.Fatal("invalid auth config, set AUTH_DISABLED=true to run without authentication", logger.Error(err))
+		}
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(authenticator.StreamServerInterceptor()))
+	}
+
+	s := grpc.NewServer(opts...)
 	pb.RegisterToDoServiceServer(s, taskService)
+	healthpb.RegisterHealthServer(s, health.NewServer())
 	reflection.Register(s)
 	log.Info("main: server running",
 		logger.String("port", cfg.RPCPort))
 
+	go func() {
+		<-ctx.Done()
+		log.Info("main: shutting down")
+		s.GracefulStop()
+	}()
+
 	if err := s.Serve(lis); err != nil {
 		log.Fatal("Error while listening: %v", logger.Error(err))
 	}
 }
+
+// newAuthenticator accepts the tokens and API keys cfg configures.
+func newAuthenticator(cfg config.Config) (*auth.Authenticator, error) {
+	opts := auth.Options{
+		HMACSecret: []byte(cfg.AuthJWTSecret),
+		Keys:       auth.KeySet{},
+		Issuer:     cfg.AuthJWTIssuer,
+		Audience:   cfg.AuthJWTAudience,
+		Leeway:     time.Minute,
+	}
+	if cfg.AuthJWKSFile != "" {
+		keys, err := auth.LoadJWKS(cfg.AuthJWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		opts.Keys = keys
+	}
+	if cfg.AuthJWTPublicKey != "" {
+		key, err := auth.LoadRSAPublicKey(cfg.AuthJWTPublicKey)
+		if err != nil {
+			return nil, err
+		}
+		// the key is stored without a kid, where a key of the JWKS may be already
+		if _, ok := opts.Keys[""]; ok {
+			return nil, fmt.Errorf("%s and AUTH_JWT_PUBLIC_KEY both configure a key without a kid", cfg.AuthJWKSFile)
+		}
+		opts.Keys[""] = key
+	}
+
+	keys, err := auth.ParseAPIKeys(cfg.AuthAPIKeys)
+	if err != nil {
+		return nil, err
+	}
+	opts.APIKeys = keys
+
+	return auth.NewAuthenticator(opts)
+}
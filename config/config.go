@@ -2,22 +2,45 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/spf13/cast"
 )
 
 // Config ...
 type Config struct {
-	Environment       string // develop, staging, production
-	PostgresHost      string
-	PostgresPort      int
-	PostgresDatabase  string
-	PostgresUser      string
-	PostgresPassword  string
-	LogLevel          string
-	RPCPort           string
-	ReviewServiceHost string
-	ReviewServicePort int
+	Environment        string // develop, staging, production
+	Storage            string // postgres, memory
+	PostgresHost       string
+	PostgresPort       int
+	PostgresDatabase   string
+	PostgresUser       string
+	PostgresPassword   string
+	LogLevel           string
+	RPCPort            string
+	DefaultTimeZone    string        // IANA name deadlines without an offset are read in
+	ReminderInterval   time.Duration // between reminder scans, 0, the default, turns reminders off
+	ReminderLeadTime   time.Duration // how long before a deadline the upcoming reminder is sent
+	ReminderOverdue    time.Duration // how long after a deadline passed the overdue reminder is still sent
+	ReminderWebhookURL string        // reminders are also POSTed here when set
+	ReminderAttempts   int           // tries of a reminder before it is marked failed
+	ReminderRetryDelay time.Duration // before the first retry of a reminder, doubled for every further one
+	ReminderMaxDelay   time.Duration // caps the wait between retries of a reminder
+	WebhookMaxAttempts int           // deliveries of a task event tried before it becomes a dead letter
+	WebhookRetryDelay  time.Duration // before the first retry of a delivery, doubled for every further one
+	WebhookMaxDelay    time.Duration // caps the wait between retries
+	OutboxInterval     time.Duration // between looks for task events to publish
+	OutboxLease        time.Duration // a relay has to publish a batch of task events before another may take it over
+	EventLog           string        // task events are also written here as JSON lines: stdout or a file path, empty for none
+	AuthDisabled       bool          // serves every caller without credentials, for local development only
+	AuthJWTSecret      string        // verifies HS256 tokens
+	AuthJWTPublicKey   string        // path of a PEM RSA public key verifying RS256 tokens
+	AuthJWKSFile       string        // path of a local JWKS file with more token keys
+	AuthJWTIssuer      string        // required iss claim of tokens when set
+	AuthJWTAudience    string        // required aud claim of tokens when set
+	AuthAPIKeys        string        // static keys as subject:key[:role|role[:workspace|workspace]], comma separated
+	ReviewServiceHost  string
+	ReviewServicePort  int
 }
 
 // Load loads environment vars and inflates Config
@@ -26,6 +49,8 @@ func Load() Config {
 
 	c.Environment = cast.ToString(getOrReturnDefault("ENVIRONMENT", "develop"))
 
+	c.Storage = cast.ToString(getOrReturnDefault("STORAGE", "postgres"))
+
 	c.PostgresHost = cast.ToString(getOrReturnDefault("POSTGRES_HOST", "localhost"))
 	c.PostgresPort = cast.ToInt(getOrReturnDefault("POSTGRES_PORT", 5432))
 	c.PostgresDatabase = cast.ToString(getOrReturnDefault("POSTGRES_DATABASE", "tododb"))
@@ -36,6 +61,32 @@ func Load() Config {
 
 	c.RPCPort = cast.ToString(getOrReturnDefault("RPC_PORT", ":9000"))
 
+	c.DefaultTimeZone = cast.ToString(getOrReturnDefault("DEFAULT_TIME_ZONE", "UTC"))
+
+	c.ReminderInterval = cast.ToDuration(getOrReturnDefault("REMINDER_INTERVAL", "0"))
+	c.ReminderLeadTime = cast.ToDuration(getOrReturnDefault("REMINDER_LEAD_TIME", "1h"))
+	c.ReminderOverdue = cast.ToDuration(getOrReturnDefault("REMINDER_OVERDUE_WINDOW", "24h"))
+	c.ReminderWebhookURL = cast.ToString(getOrReturnDefault("REMINDER_WEBHOOK_URL", ""))
+	c.ReminderAttempts = cast.ToInt(getOrReturnDefault("REMINDER_MAX_ATTEMPTS", 5))
+	c.ReminderRetryDelay = cast.ToDuration(getOrReturnDefault("REMINDER_RETRY_DELAY", "1m"))
+	c.ReminderMaxDelay = cast.ToDuration(getOrReturnDefault("REMINDER_MAX_DELAY", "1h"))
+
+	c.WebhookMaxAttempts = cast.ToInt(getOrReturnDefault("WEBHOOK_MAX_ATTEMPTS", 5))
+	c.WebhookRetryDelay = cast.ToDuration(getOrReturnDefault("WEBHOOK_RETRY_DELAY", "1s"))
+	c.WebhookMaxDelay = cast.ToDuration(getOrReturnDefault("WEBHOOK_MAX_DELAY", "5m"))
+
+	c.OutboxInterval = cast.ToDuration(getOrReturnDefault("OUTBOX_INTERVAL", "1s"))
+	c.OutboxLease = cast.ToDuration(getOrReturnDefault("OUTBOX_LEASE", "5m"))
+	c.EventLog = cast.ToString(getOrReturnDefault("EVENT_LOG", ""))
+
+	c.AuthDisabled = cast.ToBool(getOrReturnDefault("AUTH_DISABLED", false))
+	c.AuthJWTSecret = cast.ToString(getOrReturnDefault("AUTH_JWT_SECRET", ""))
+	c.AuthJWTPublicKey = cast.ToString(getOrReturnDefault("AUTH_JWT_PUBLIC_KEY", ""))
+	c.AuthJWKSFile = cast.ToString(getOrReturnDefault("AUTH_JWKS_FILE", ""))
+	c.AuthJWTIssuer = cast.ToString(getOrReturnDefault("AUTH_JWT_ISSUER", ""))
+	c.AuthJWTAudience = cast.ToString(getOrReturnDefault("AUTH_JWT_AUDIENCE", ""))
+	c.AuthAPIKeys = cast.ToString(getOrReturnDefault("AUTH_API_KEYS", ""))
+
 	return c
 }
 
@@ -0,0 +1,3 @@
+ALTER TABLE todos DROP CONSTRAINT IF EXISTS todos_status_check;
+ALTER TABLE todos ALTER COLUMN status DROP NOT NULL;
+ALTER TABLE todos ALTER COLUMN status DROP DEFAULT;
@@ -0,0 +1,12 @@
+UPDATE todos SET status = CASE
+    WHEN lower(trim(status)) IN ('in_progress', 'in progress', 'inprogress', 'doing', 'started', 'active') THEN 'in_progress'
+    WHEN lower(trim(status)) IN ('blocked', 'on hold', 'on_hold', 'waiting') THEN 'blocked'
+    WHEN lower(trim(status)) IN ('done', 'completed', 'complete', 'finished', 'closed', 'passed') THEN 'done'
+    WHEN lower(trim(status)) IN ('cancelled', 'canceled', 'rejected', 'dropped') THEN 'cancelled'
+    ELSE 'todo'
+END;
+
+ALTER TABLE todos ALTER COLUMN status SET DEFAULT 'todo';
+ALTER TABLE todos ALTER COLUMN status SET NOT NULL;
+ALTER TABLE todos ADD CONSTRAINT todos_status_check
+    CHECK (status IN ('todo', 'in_progress', 'blocked', 'done', 'cancelled'));
@@ -0,0 +1,5 @@
+ALTER TABLE todos ADD COLUMN search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(summary, '')), 'B')
+) STORED;
+CREATE INDEX IF NOT EXISTS todos_search_idx ON todos USING GIN (search);
@@ -0,0 +1,5 @@
+ALTER TABLE todos
+    ALTER COLUMN deadline TYPE timestamp USING deadline AT TIME ZONE 'UTC',
+    ALTER COLUMN created_at TYPE timestamp USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE timestamp USING updated_at AT TIME ZONE 'UTC',
+    ALTER COLUMN deleted_at TYPE timestamp USING deleted_at AT TIME ZONE 'UTC';
@@ -0,0 +1,7 @@
+-- Until now timestamps were stored without a zone, in the server's local time.
+-- Servers run in UTC, so that is how existing values are read.
+ALTER TABLE todos
+    ALTER COLUMN deadline TYPE timestamptz USING deadline AT TIME ZONE 'UTC',
+    ALTER COLUMN created_at TYPE timestamptz USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE timestamptz USING updated_at AT TIME ZONE 'UTC',
+    ALTER COLUMN deleted_at TYPE timestamptz USING deleted_at AT TIME ZONE 'UTC';
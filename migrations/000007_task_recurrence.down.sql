@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS todos_series_id_idx;
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS occurrence,
+    DROP COLUMN IF EXISTS series_id,
+    DROP COLUMN IF EXISTS recurrence,
+    DROP COLUMN IF EXISTS time_zone;
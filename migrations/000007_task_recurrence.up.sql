@@ -0,0 +1,6 @@
+ALTER TABLE todos
+    ADD COLUMN time_zone varchar(64) NOT NULL DEFAULT '',
+    ADD COLUMN recurrence varchar(200) NOT NULL DEFAULT '',
+    ADD COLUMN series_id uuid NULL,
+    ADD COLUMN occurrence bigint NOT NULL DEFAULT 0;
+CREATE INDEX todos_series_id_idx ON todos (series_id, occurrence);
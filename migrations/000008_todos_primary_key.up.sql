@@ -0,0 +1,2 @@
+-- todos.id lost its primary key when it became a uuid in 000002.
+ALTER TABLE todos ADD CONSTRAINT todos_pkey PRIMARY KEY (id);
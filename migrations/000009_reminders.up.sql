@@ -0,0 +1,7 @@
+CREATE TABLE reminders (
+    task_id uuid NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    kind varchar(20) NOT NULL,
+    deadline timestamptz NOT NULL,
+    sent_at timestamptz NOT NULL,
+    PRIMARY KEY (task_id, kind, deadline)
+);
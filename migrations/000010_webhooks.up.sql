@@ -0,0 +1,35 @@
+CREATE TABLE webhooks (
+    id uuid PRIMARY KEY,
+    url varchar(2048) NOT NULL,
+    events text[] NOT NULL,
+    secret varchar(128) NOT NULL,
+    disabled boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE webhook_deliveries (
+    id uuid PRIMARY KEY,
+    webhook_id uuid NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id uuid NOT NULL,
+    event varchar(50) NOT NULL,
+    attempt int NOT NULL,
+    status_code int NOT NULL DEFAULT 0,
+    error text NOT NULL DEFAULT '',
+    success boolean NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, created_at DESC);
+
+-- Events that could not be delivered after the last retry, kept for inspection and replay.
+CREATE TABLE webhook_dead_letters (
+    id uuid PRIMARY KEY,
+    webhook_id uuid NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id uuid NOT NULL,
+    event varchar(50) NOT NULL,
+    payload jsonb NOT NULL,
+    attempts int NOT NULL,
+    last_error text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX webhook_dead_letters_webhook_id_idx ON webhook_dead_letters (webhook_id);
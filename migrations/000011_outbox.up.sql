@@ -0,0 +1,12 @@
+-- Task events, written in the same transaction as the change of todos they describe and
+-- published by the relay in seq order.
+CREATE TABLE outbox (
+    seq bigserial PRIMARY KEY,
+    event_id uuid NOT NULL UNIQUE,
+    event varchar(50) NOT NULL,
+    task_id uuid NOT NULL,
+    task jsonb NOT NULL,
+    created_at timestamptz NOT NULL,
+    delivered_at timestamptz NULL
+);
+CREATE INDEX outbox_pending_idx ON outbox (seq) WHERE delivered_at IS NULL;
@@ -0,0 +1,3 @@
+-- The relay numbers the events it delivers, so watchers can resume after the last one they got.
+-- Relays take turns, so revisions follow the order of delivery without gaps.
+ALTER TABLE outbox ADD COLUMN revision bigint NULL UNIQUE;
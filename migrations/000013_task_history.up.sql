@@ -0,0 +1,12 @@
+-- Every create, update, delete and restore of a task, written in the same transaction as the
+-- change. Without a foreign key, so the history of a purged task is kept.
+CREATE TABLE task_history (
+    id bigserial PRIMARY KEY,
+    task_id uuid NOT NULL,
+    action varchar(20) NOT NULL,
+    actor varchar(255) NOT NULL DEFAULT '',
+    fields jsonb NOT NULL DEFAULT '[]',
+    version bigint NOT NULL,
+    changed_at timestamptz NOT NULL
+);
+CREATE INDEX task_history_task_idx ON task_history (task_id, id DESC);
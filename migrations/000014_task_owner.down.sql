@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS todos_assignee_idx;
+DROP INDEX IF EXISTS todos_owner_idx;
+ALTER TABLE todos DROP COLUMN IF EXISTS owner;
@@ -0,0 +1,5 @@
+-- Tasks created before owners existed have none; only admins see them unless they are
+-- assigned to someone.
+ALTER TABLE todos ADD COLUMN owner varchar(255) NOT NULL DEFAULT '';
+CREATE INDEX todos_owner_idx ON todos (owner);
+CREATE INDEX todos_assignee_idx ON todos (assignee);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS todos_workspace_id_idx;
+ALTER TABLE todos DROP COLUMN IF EXISTS workspace_id;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,14 @@
+CREATE TABLE workspaces (
+    id uuid PRIMARY KEY,
+    name varchar(100) NOT NULL UNIQUE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- Tasks created before workspaces existed, and those of requests that name none, go to
+-- the default workspace.
+INSERT INTO workspaces (id, name) VALUES ('00000000-0000-0000-0000-000000000000', 'default');
+
+ALTER TABLE todos ADD COLUMN workspace_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000'
+    REFERENCES workspaces(id);
+CREATE INDEX todos_workspace_id_idx ON todos (workspace_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS todos_project_id_idx;
+ALTER TABLE todos DROP CONSTRAINT IF EXISTS todos_project_fkey;
+ALTER TABLE todos DROP COLUMN IF EXISTS project_id;
+DROP TABLE IF EXISTS projects;
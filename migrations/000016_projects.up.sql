@@ -0,0 +1,18 @@
+CREATE TABLE projects (
+    id uuid PRIMARY KEY,
+    workspace_id uuid NOT NULL REFERENCES workspaces(id),
+    name varchar(100) NOT NULL,
+    description varchar(500) NOT NULL DEFAULT '',
+    color varchar(7) NOT NULL DEFAULT '',
+    archived boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (workspace_id, name),
+    -- the target of todos_project_fkey, which keeps tasks to the projects of their workspace
+    UNIQUE (workspace_id, id)
+);
+
+ALTER TABLE todos ADD COLUMN project_id uuid NULL;
+ALTER TABLE todos ADD CONSTRAINT todos_project_fkey
+    FOREIGN KEY (workspace_id, project_id) REFERENCES projects (workspace_id, id);
+CREATE INDEX todos_project_id_idx ON todos (project_id);
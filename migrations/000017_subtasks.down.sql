@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS todos_parent_id_idx;
+ALTER TABLE todos DROP CONSTRAINT IF EXISTS todos_parent_fkey;
+ALTER TABLE todos DROP COLUMN IF EXISTS parent_id;
+ALTER TABLE todos DROP CONSTRAINT IF EXISTS todos_workspace_id_id_key;
@@ -0,0 +1,9 @@
+-- the target of todos_parent_fkey, which keeps subtasks to the workspace of their parent
+ALTER TABLE todos ADD CONSTRAINT todos_workspace_id_id_key UNIQUE (workspace_id, id);
+
+-- Deletes and purges of a task take its subtasks along in taskRepo, so the foreign key
+-- doesn't cascade: a subtask is never removed behind the repository's back.
+ALTER TABLE todos ADD COLUMN parent_id uuid NULL;
+ALTER TABLE todos ADD CONSTRAINT todos_parent_fkey
+    FOREIGN KEY (workspace_id, parent_id) REFERENCES todos (workspace_id, id);
+CREATE INDEX todos_parent_id_idx ON todos (parent_id);
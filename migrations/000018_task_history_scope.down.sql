@@ -0,0 +1,4 @@
+ALTER TABLE task_history
+    DROP COLUMN IF EXISTS assignee,
+    DROP COLUMN IF EXISTS owner,
+    DROP COLUMN IF EXISTS workspace_id;
@@ -0,0 +1,10 @@
+-- The workspace, owner and assignee of a task as of each change, so who may read the
+-- history of a task is known once the task is purged too.
+ALTER TABLE task_history
+    ADD COLUMN workspace_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    ADD COLUMN owner varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN assignee varchar(50) NOT NULL DEFAULT '';
+
+-- The history of tasks purged before this keeps the defaults, which only admins see.
+UPDATE task_history SET workspace_id = todos.workspace_id, owner = todos.owner, assignee = coalesce(todos.assignee, '')
+FROM todos WHERE todos.id = task_history.task_id;
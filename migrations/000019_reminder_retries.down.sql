@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS reminders_retry_at_idx;
+ALTER TABLE reminders
+    DROP COLUMN IF EXISTS failed,
+    DROP COLUMN IF EXISTS retry_at,
+    DROP COLUMN IF EXISTS attempts;
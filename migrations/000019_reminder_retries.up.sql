@@ -0,0 +1,7 @@
+-- A reminder whose delivery failed is retried at retry_at, and marked failed once the
+-- scheduler gives up on it.
+ALTER TABLE reminders
+    ADD COLUMN attempts integer NOT NULL DEFAULT 1,
+    ADD COLUMN retry_at timestamptz NULL,
+    ADD COLUMN failed boolean NOT NULL DEFAULT false;
+CREATE INDEX reminders_retry_at_idx ON reminders (retry_at) WHERE retry_at IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS outbox_claimed_until_idx;
+ALTER TABLE outbox DROP COLUMN IF EXISTS claimed_until;
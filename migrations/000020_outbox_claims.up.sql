@@ -0,0 +1,4 @@
+-- A relay claims the events it publishes until claimed_until, and publishes them outside
+-- of any transaction. Other relays wait for the claim to be lifted or to run out.
+ALTER TABLE outbox ADD COLUMN claimed_until timestamptz NULL;
+CREATE INDEX outbox_claimed_until_idx ON outbox (claimed_until) WHERE claimed_until IS NOT NULL;
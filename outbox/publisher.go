@@ -0,0 +1,116 @@
+// Package outbox relays the task events storage writes along with every change: a Relay
+// reads the pending ones in order, hands them to an EventPublisher and marks the published
+// ones delivered. An event is published at least once; one whose delivery could not be
+// marked is published again, so consumers tell repeats apart by the event id.
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+// EventPublisher passes events on to whoever is interested in them. An event counts as
+// published once Publish returns nil.
+type EventPublisher interface {
+	Publish(ctx context.Context, e repo.Event) error
+}
+
+// Publishers publishes every event to each of its publishers in turn and stops at the
+// first that fails. Those before it see the event again when it is retried.
+type Publishers []EventPublisher
+
+func (ps Publishers) Publish(ctx context.Context, e repo.Event) error {
+	for _, p := range ps {
+		if err := p.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MemoryPublisher keeps the events published to it, for tests.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []repo.Event
+}
+
+// NewMemoryPublisher ...
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, e repo.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, e)
+	return nil
+}
+
+// Events returns the events published so far, oldest first.
+func (p *MemoryPublisher) Events() []repo.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]repo.Event(nil), p.events...)
+}
+
+// WriterPublisher writes every event to an io.Writer as one line of JSON, see Encode.
+type WriterPublisher struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterPublisher returns a publisher writing to w, which is usually os.Stdout or a
+// file opened for appending.
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{w: w}
+}
+
+func (p *WriterPublisher) Publish(_ context.Context, e repo.Event) error {
+	line, err := Encode(e)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, err = p.w.Write(append(line, '\n'))
+	return err
+}
+
+// Payload is how an event is rendered for the outside. Task is rendered the way the gRPC
+// gateway renders a pb.Task, with the proto field names.
+type Payload struct {
+	ID         string          `json:"id"`
+	Type       repo.EventType  `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Task       json.RawMessage `json:"task"`
+}
+
+// taskMarshaler renders tasks for payloads.
+var taskMarshaler = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+
+// Encode renders e as a JSON Payload.
+func Encode(e repo.Event) ([]byte, error) {
+	var task bytes.Buffer
+	if err := taskMarshaler.Marshal(&task, &e.Task); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(Payload{
+		ID:         e.ID,
+		Type:       e.Type,
+		OccurredAt: e.OccurredAt.UTC(),
+		Task:       task.Bytes(),
+	})
+}
@@ -0,0 +1,110 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// Defaults for what Options leave unset.
+const (
+	defaultInterval  = time.Second
+	defaultBatchSize = 100
+	defaultLease     = 5 * time.Minute
+)
+
+// Options configure a Relay.
+type Options struct {
+	// Interval is the wait between looks at the outbox once it has been emptied.
+	Interval time.Duration
+	// BatchSize is how many events are read from the outbox at a time.
+	BatchSize int
+	// Lease is how long a batch may take to publish. Events still being published then
+	// are cut short and stay pending, as the relays of other instances may take them over.
+	Lease time.Duration
+	// Now reads the clock, time.Now when nil.
+	Now func() time.Time
+	// Relayed is called after events were marked delivered, when set.
+	Relayed func()
+}
+
+// Relay publishes the pending events of an outbox in the order they were written.
+type Relay struct {
+	outbox    repo.OutboxStorageI
+	publisher EventPublisher
+	logger    l.Logger
+	opts      Options
+}
+
+// NewRelay ...
+func NewRelay(outbox repo.OutboxStorageI, publisher EventPublisher, log l.Logger, opts Options) *Relay {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = defaultLease
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	return &Relay{outbox: outbox, publisher: publisher, logger: log, opts: opts}
+}
+
+// Run relays events every Interval until ctx is done. A full batch is followed by the
+// next one right away, so a backlog doesn't wait for the ticker.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := r.Flush(ctx)
+			if err != nil {
+				r.logger.Error("failed to relay outbox events", l.Int("relayed", n), l.Error(err))
+			}
+			if err != nil || n < r.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush publishes up to BatchSize pending events within Lease and marks them delivered.
+// It stops at the first event that fails to publish and returns that error; the event and
+// the ones after it stay pending. Flush returns 0 while another relay is publishing.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.opts.Lease)
+	defer cancel()
+
+	var publishErr error
+	n, err := r.outbox.Relay(r.opts.BatchSize, r.opts.Now(), r.opts.Lease, func(events []repo.Event) int {
+		for i, e := range events {
+			if err := r.publisher.Publish(ctx, e); err != nil {
+				publishErr = fmt.Errorf("publish %s event %s: %w", e.Type, e.ID, err)
+				return i
+			}
+		}
+		return len(events)
+	})
+	if err != nil {
+		return n, err
+	}
+	if n > 0 && r.opts.Relayed != nil {
+		r.opts.Relayed()
+	}
+
+	return n, publishErr
+}
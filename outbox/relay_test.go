@@ -0,0 +1,187 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/memory"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+)
+
+// failing fails to publish the events of tasks with the given title.
+type failing struct {
+	title string
+}
+
+func (f failing) Publish(_ context.Context, e repo.Event) error {
+	if e.Task.Title == f.title {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func create(t *testing.T, tasks repo.TaskStorageI, titles ...string) {
+	t.Helper()
+
+	for _, title := range titles {
+		if _, err := tasks.Create(pb.Task{Id: uuid.Must(uuid.NewV4()).String(), Title: title, Status: "todo"}); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+	}
+}
+
+func titles(events []repo.Event) []string {
+	got := []string{}
+	for _, e := range events {
+		got = append(got, e.Task.Title)
+	}
+	return got
+}
+
+func TestRelay_Flush(t *testing.T) {
+	tests := []struct {
+		name      string
+		failOn    string
+		batchSize int
+		wantN     int
+		wantErr   bool
+		wantSent  []string
+		wantAfter []string // published by a second, healthy relay
+	}{
+		{name: "all", wantN: 3, wantSent: []string{"One", "Two", "Three"}, wantAfter: []string{}},
+		{name: "batch size", batchSize: 2, wantN: 2, wantSent: []string{"One", "Two"}, wantAfter: []string{"Three"}},
+		{name: "failure", failOn: "Two", wantN: 1, wantErr: true, wantSent: []string{"One"}, wantAfter: []string{"Two", "Three"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := memory.NewTaskRepo()
+			store := memory.NewOutboxRepo(tasks)
+			create(t, tasks, "One", "Two", "Three")
+
+			sent := NewMemoryPublisher()
+			relay := NewRelay(store, Publishers{failing{title: tt.failOn}, sent}, l.New("error", "test"), Options{BatchSize: tt.batchSize})
+			n, err := relay.Flush(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Flush() error = %v, want error: %v", err, tt.wantErr)
+			}
+			if n != tt.wantN {
+				t.Errorf("Flush() = %d, want %d", n, tt.wantN)
+			}
+			if got := titles(sent.Events()); strings.Join(got, ",") != strings.Join(tt.wantSent, ",") {
+				t.Errorf("published %v, want %v", got, tt.wantSent)
+			}
+
+			after := NewMemoryPublisher()
+			if _, err := NewRelay(store, after, l.New("error", "test"), Options{}).Flush(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if got := titles(after.Events()); strings.Join(got, ",") != strings.Join(tt.wantAfter, ",") {
+				t.Errorf("left pending %v, want %v", got, tt.wantAfter)
+			}
+		})
+	}
+}
+
+// stuck publishes nothing until ctx is done.
+type stuck struct{}
+
+func (stuck) Publish(ctx context.Context, _ repo.Event) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestRelay_Lease(t *testing.T) {
+	tasks := memory.NewTaskRepo()
+	store := memory.NewOutboxRepo(tasks)
+	create(t, tasks, "One")
+
+	n, err := NewRelay(store, stuck{}, l.New("error", "test"), Options{Lease: 10 * time.Millisecond}).Flush(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) || n != 0 {
+		t.Fatalf("Flush() = %d, %v, want the publisher cut short at the end of the lease", n, err)
+	}
+
+	after := NewMemoryPublisher()
+	if _, err := NewRelay(store, after, l.New("error", "test"), Options{}).Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := titles(after.Events()); strings.Join(got, ",") != "One" {
+		t.Errorf("left pending %v, want the event cut short", got)
+	}
+}
+
+func TestRelay_Run(t *testing.T) {
+	tasks := memory.NewTaskRepo()
+	sent := NewMemoryPublisher()
+	relay := NewRelay(memory.NewOutboxRepo(tasks), sent, l.New("error", "test"), Options{Interval: time.Millisecond, BatchSize: 2})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+
+	create(t, tasks, "One", "Two", "Three", "Four", "Five")
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sent.Events()) < 5 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if got := titles(sent.Events()); strings.Join(got, ",") != "One,Two,Three,Four,Five" {
+		t.Errorf("published %v, want all five tasks in order", got)
+	}
+}
+
+func TestWriterPublisher(t *testing.T) {
+	var out bytes.Buffer
+	p := NewWriterPublisher(&out)
+	at := time.Date(2021, 12, 22, 10, 0, 0, 0, time.FixedZone("UTC+5", 5*3600))
+	events := []repo.Event{
+		{ID: "b3d4f1a2-0c39-4a4e-9d0f-5b1d1c9c1f10", Type: repo.EventTaskCreated, Task: pb.Task{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Title: "Test"}, OccurredAt: at},
+		{ID: "5f0e8a3c-2f6b-4c5e-8e7d-1a2b3c4d5e6f", Type: repo.EventTaskDeleted, Task: pb.Task{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Title: "Test"}, OccurredAt: at},
+	}
+	for _, e := range events {
+		if err := p.Publish(context.Background(), e); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != len(events) {
+		t.Fatalf("got %d lines, want one per event: %q", len(lines), out.String())
+	}
+	for i, line := range lines {
+		var got struct {
+			Payload
+			Task struct {
+				ID     string `json:"id"`
+				Title  string `json:"Title"`
+				Status string `json:"Status"`
+			} `json:"task"`
+		}
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatalf("line %d is not JSON: %v", i, err)
+		}
+		want := events[i]
+		if got.ID != want.ID || got.Type != want.Type || !got.OccurredAt.Equal(at) || got.OccurredAt.Location() != time.UTC {
+			t.Errorf("line %d = %+v, want %+v in UTC", i, got.Payload, want)
+		}
+		if got.Task.ID != want.Task.Id || got.Task.Title != want.Task.Title {
+			t.Errorf("line %d task = %+v, want %+v", i, got.Task, want.Task)
+		}
+	}
+	if !strings.Contains(lines[0], `"Status":""`) {
+		t.Errorf("line %q leaves out empty task fields", lines[0])
+	}
+}
@@ -0,0 +1,176 @@
+// Package auth authenticates gRPC callers by a JWT bearer token or a static API key and
+// puts who they are in the request context.
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Metadata keys callers put their credentials in.
+const (
+	AuthorizationHeader = "authorization"
+	APIKeyHeader        = "x-api-key"
+)
+
+// RoleAdmin is the role of callers allowed to see and change every task.
+const RoleAdmin = "admin"
+
+var (
+	// ErrNoCredentials is returned for a request that carries neither a token nor an API key.
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials is returned for a token or API key that is not accepted.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Identity is the authenticated caller.
+type Identity struct {
+	Subject string
+	Roles   []string
+	// Workspaces are the ids of the workspaces the caller is a member of.
+	Workspaces []string
+	// Method is how the caller authenticated: "jwt" or "api_key", or "none" for Anonymous.
+	Method string
+}
+
+// Anonymous is the identity of every caller when authentication is disabled: an admin
+// without a subject.
+var Anonymous = Identity{Roles: []string{RoleAdmin}, Method: "none"}
+
+// HasRole tells whether the caller has role.
+func (id Identity) HasRole(role string) bool {
+	for _, r := range id.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type identityKey struct{}
+
+// NewContext returns ctx carrying id.
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity ctx carries, and false when the request was not
+// authenticated.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// APIKey is a static key and the identity of whoever presents it.
+type APIKey struct {
+	Key        string
+	Subject    string
+	Roles      []string
+	Workspaces []string
+}
+
+// ParseAPIKeys parses a comma-separated list of subject:key entries, each optionally
+// followed by :role|role and then :workspace|workspace, e.g. "ci:s3cret::<workspace id>,ops:0th3r:admin".
+func ParseAPIKeys(s string) ([]APIKey, error) {
+	var keys []APIKey
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) < 2 || len(parts) > 4 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("API key entry %q is not subject:key[:roles[:workspaces]]", entry)
+		}
+		key := APIKey{Subject: parts[0], Key: parts[1]}
+		if len(parts) >= 3 && parts[2] != "" {
+			key.Roles = strings.Split(parts[2], "|")
+		}
+		if len(parts) == 4 && parts[3] != "" {
+			key.Workspaces = strings.Split(parts[3], "|")
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Options configure an Authenticator. At least one of the key sources must be set.
+type Options struct {
+	// HMACSecret verifies HS256 tokens.
+	HMACSecret []byte
+	// Keys verifies RS256 tokens, and HS256 ones with the kid of a symmetric key.
+	Keys KeySet
+	// Issuer and Audience, when set, must match the iss and aud claims of a token.
+	Issuer   string
+	Audience string
+	// Leeway is the clock skew allowed when checking exp and nbf.
+	Leeway time.Duration
+	// APIKeys are the static keys accepted in APIKeyHeader.
+	APIKeys []APIKey
+	// Now is the clock tokens are checked against, time.Now when nil.
+	Now func() time.Time
+}
+
+// Authenticator checks the credentials of requests.
+type Authenticator struct {
+	opts Options
+	// apiKeys maps the SHA-256 of each key to its identity, so the lookup time doesn't
+	// depend on how much of a key a caller guessed right.
+	apiKeys map[[sha256.Size]byte]Identity
+}
+
+// NewAuthenticator returns an Authenticator for opts.
+func NewAuthenticator(opts Options) (*Authenticator, error) {
+	if len(opts.HMACSecret) == 0 && len(opts.Keys) == 0 && len(opts.APIKeys) == 0 {
+		return nil, errors.New("auth: no JWT keys or API keys configured")
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	a := &Authenticator{opts: opts, apiKeys: map[[sha256.Size]byte]Identity{}}
+	for _, key := range opts.APIKeys {
+		a.apiKeys[sha256.Sum256([]byte(key.Key))] = Identity{Subject: key.Subject, Roles: key.Roles, Workspaces: key.Workspaces, Method: "api_key"}
+	}
+	return a, nil
+}
+
+// Authenticate returns the identity of the caller who sent the authorization or x-api-key
+// value given. A bearer token takes precedence over an API key.
+func (a *Authenticator) Authenticate(authorization, apiKey string) (Identity, error) {
+	switch {
+	case authorization != "":
+		scheme, token := splitAuthorization(authorization)
+		if !strings.EqualFold(scheme, "bearer") || token == "" {
+			return Identity{}, fmt.Errorf("%w: authorization must be a bearer token", ErrInvalidCredentials)
+		}
+		return a.verifyToken(token)
+	case apiKey != "":
+		return a.checkAPIKey(apiKey)
+	}
+	return Identity{}, ErrNoCredentials
+}
+
+func (a *Authenticator) checkAPIKey(key string) (Identity, error) {
+	sum := sha256.Sum256([]byte(key))
+	for known, id := range a.apiKeys {
+		if subtle.ConstantTimeCompare(known[:], sum[:]) == 1 {
+			return id, nil
+		}
+	}
+	return Identity{}, fmt.Errorf("%w: unknown API key", ErrInvalidCredentials)
+}
+
+func splitAuthorization(value string) (string, string) {
+	value = strings.TrimSpace(value)
+	i := strings.IndexByte(value, ' ')
+	if i < 0 {
+		return value, ""
+	}
+	return value[:i], strings.TrimSpace(value[i+1:])
+}
@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2021, 12, 21, 12, 0, 0, 0, time.UTC)
+
+func segment(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func hs256(t *testing.T, secret []byte, header, claims map[string]interface{}) string {
+	t.Helper()
+
+	signed := segment(t, header) + "." + segment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed)) // nolint:errcheck
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func rs256(t *testing.T, key *rsa.PrivateKey, header, claims map[string]interface{}) string {
+	t.Helper()
+
+	signed := segment(t, header) + "." + segment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestAuthenticate_HS256(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	a, err := NewAuthenticator(Options{HMACSecret: secret, Issuer: "auth.example.com", Audience: "todo", Now: func() time.Time { return now }})
+	if err != nil {
+		t.Fatalf("new authenticator: %v", err)
+	}
+
+	valid := map[string]interface{}{
+		"sub": "lola", "iss": "auth.example.com", "aud": []string{"todo", "other"},
+		"exp": now.Add(time.Hour).Unix(), "nbf": now.Add(-time.Hour).Unix(), "roles": []string{"admin"}, "workspaces": []string{"w1"},
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := map[string]interface{}{}
+		for k, v := range valid {
+			claims[k] = v
+		}
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+	hs := map[string]interface{}{"alg": "HS256", "typ": "JWT"}
+
+	id, err := a.Authenticate("Bearer "+hs256(t, secret, hs, valid), "")
+	if err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	if want := (Identity{Subject: "lola", Roles: []string{"admin"}, Workspaces: []string{"w1"}, Method: "jwt"}); !reflect.DeepEqual(id, want) {
+		t.Fatalf("expected %v, got %v", want, id)
+	}
+	if !id.HasRole(RoleAdmin) {
+		t.Errorf("expected the admin role")
+	}
+
+	token := hs256(t, secret, hs, valid)
+	tests := map[string]string{
+		"wrong secret":    hs256(t, []byte("another secret"), hs, valid),
+		"alg none":        segment(t, map[string]string{"alg": "none"}) + "." + segment(t, valid) + ".",
+		"tampered claims": token[:len(token)-2] + "xx",
+		"expired":         hs256(t, secret, hs, with("exp", now.Add(-time.Second).Unix())),
+		"no expiry":       hs256(t, secret, hs, with("exp", nil)),
+		"not yet valid":   hs256(t, secret, hs, with("nbf", now.Add(time.Hour).Unix())),
+		"other issuer":    hs256(t, secret, hs, with("iss", "evil.example.com")),
+		"other audience":  hs256(t, secret, hs, with("aud", "billing")),
+		"no subject":      hs256(t, secret, hs, with("sub", nil)),
+		"malformed":       "not.a-token",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := a.Authenticate("Bearer "+token, ""); !errors.Is(err, ErrInvalidCredentials) {
+				t.Fatalf("expected invalid credentials, got: %v", err)
+			}
+		})
+	}
+
+	if _, err := a.Authenticate("Basic bG9sYTpwYXNz", ""); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected basic auth to be refused, got: %v", err)
+	}
+	if _, err := a.Authenticate("", ""); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("expected no credentials, got: %v", err)
+	}
+}
+
+func TestAuthenticate_RS256WithJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	jwks := fmt.Sprintf(`{"keys": [
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": %q, "e": %q},
+		{"kty": "RSA", "kid": "rsa-enc", "use": "enc", "n": %q, "e": "AQAB"},
+		{"kty": "oct", "kid": "hmac-1", "k": %q}
+	]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		base64.RawURLEncoding.EncodeToString(other.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString([]byte("jwks secret")))
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, []byte(jwks), 0o600); err != nil {
+		t.Fatalf("write jwks: %v", err)
+	}
+
+	keys, err := LoadJWKS(path)
+	if err != nil {
+		t.Fatalf("load jwks: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("expected the two signing keys, got: %v", keys)
+	}
+	a, err := NewAuthenticator(Options{Keys: keys, Now: func() time.Time { return now }})
+	if err != nil {
+		t.Fatalf("new authenticator: %v", err)
+	}
+
+	claims := map[string]interface{}{"sub": "lola", "exp": now.Add(time.Hour).Unix()}
+	for name, token := range map[string]string{
+		"rs256 by kid":       rs256(t, key, map[string]interface{}{"alg": "RS256", "kid": "rsa-1"}, claims),
+		"rs256 without kid":  rs256(t, key, map[string]interface{}{"alg": "RS256"}, claims),
+		"hs256 by jwks kid":  hs256(t, []byte("jwks secret"), map[string]interface{}{"alg": "HS256", "kid": "hmac-1"}, claims),
+		"hs256 without kids": hs256(t, []byte("jwks secret"), map[string]interface{}{"alg": "HS256"}, claims),
+	} {
+		if id, err := a.Authenticate("Bearer "+token, ""); err != nil || id.Subject != "lola" {
+			t.Errorf("%s: expected lola, got %v, %v", name, id, err)
+		}
+	}
+
+	for name, token := range map[string]string{
+		"other key":        rs256(t, other, map[string]interface{}{"alg": "RS256", "kid": "rsa-1"}, claims),
+		"encryption key":   rs256(t, other, map[string]interface{}{"alg": "RS256", "kid": "rsa-enc"}, claims),
+		"kid of other alg": hs256(t, []byte("jwks secret"), map[string]interface{}{"alg": "HS256", "kid": "rsa-1"}, claims),
+		"unsupported alg":  rs256(t, key, map[string]interface{}{"alg": "RS512", "kid": "rsa-1"}, claims),
+	} {
+		if _, err := a.Authenticate("Bearer "+token, ""); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("%s: expected invalid credentials, got: %v", name, err)
+		}
+	}
+}
+
+func TestAuthenticate_APIKeys(t *testing.T) {
+	keys, err := ParseAPIKeys("ci:ci-key, ops:ops-key:admin|auditor, team:team-key::w1|w2,")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	a, err := NewAuthenticator(Options{APIKeys: keys})
+	if err != nil {
+		t.Fatalf("new authenticator: %v", err)
+	}
+
+	id, err := a.Authenticate("", "ops-key")
+	if err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	if want := (Identity{Subject: "ops", Roles: []string{"admin", "auditor"}, Method: "api_key"}); !reflect.DeepEqual(id, want) {
+		t.Fatalf("expected %v, got %v", want, id)
+	}
+	if id, err := a.Authenticate("", "ci-key"); err != nil || id.Subject != "ci" || id.Roles != nil {
+		t.Errorf("expected ci without roles, got %v, %v", id, err)
+	}
+	if id, err := a.Authenticate("", "team-key"); err != nil || id.Roles != nil || !reflect.DeepEqual(id.Workspaces, []string{"w1", "w2"}) {
+		t.Errorf("expected team in w1 and w2, got %v, %v", id, err)
+	}
+	if _, err := a.Authenticate("", "ops-ke"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected invalid credentials, got: %v", err)
+	}
+
+	for _, bad := range []string{"no-key", ":key", "ci:", "a:b:c:d:e"} {
+		if _, err := ParseAPIKeys(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+	if _, err := NewAuthenticator(Options{}); err == nil {
+		t.Errorf("expected an authenticator without keys to be refused")
+	}
+}
+
+func TestExempt(t *testing.T) {
+	for method, want := range map[string]bool{
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
+		"/grpc.health.v1.Health/Check":                                   true,
+		"/grpc.health.v1.Health/Watch":                                   true,
+		"/todo.ToDoService/Delete":                                       false,
+		"/grpc.health.v1.HealthEvil/Check":                               false,
+	} {
+		if got := Exempt(method); got != want {
+			t.Errorf("%s: expected %v, got %v", method, want, got)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type claims struct {
+	Subject   string       `json:"sub"`
+	Issuer    string       `json:"iss"`
+	Audience  audience     `json:"aud"`
+	ExpiresAt *json.Number `json:"exp"`
+	NotBefore *json.Number `json:"nbf"`
+	Roles     []string     `json:"roles"`
+	// Workspaces are the ids of the workspaces the subject is a member of.
+	Workspaces []string `json:"workspaces"`
+}
+
+// audience is the aud claim, which may be a single string or an array of them.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*a = audience{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+func (a audience) contains(s string) bool {
+	for _, v := range a {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyToken checks the signature and claims of a compact JWS. Only HS256 and RS256 are
+// accepted, so neither "none" nor an RSA public key used as an HMAC secret gets through.
+func (a *Authenticator) verifyToken(token string) (Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Identity{}, fmt.Errorf("%w: malformed token", ErrInvalidCredentials)
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return Identity{}, fmt.Errorf("%w: malformed token header", ErrInvalidCredentials)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: malformed token signature", ErrInvalidCredentials)
+	}
+	if !a.verifySignature(h, parts[0]+"."+parts[1], signature) {
+		return Identity{}, fmt.Errorf("%w: bad token signature", ErrInvalidCredentials)
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return Identity{}, fmt.Errorf("%w: malformed token claims", ErrInvalidCredentials)
+	}
+	if err := a.checkClaims(c); err != nil {
+		return Identity{}, err
+	}
+
+	return Identity{Subject: c.Subject, Roles: c.Roles, Workspaces: c.Workspaces, Method: "jwt"}, nil
+}
+
+func (a *Authenticator) verifySignature(h header, signed string, signature []byte) bool {
+	digest := sha256.Sum256([]byte(signed))
+	switch h.Alg {
+	case "HS256":
+		secrets := a.keys(h.Kid, func(key interface{}) bool { _, ok := key.([]byte); return ok })
+		if len(secrets) == 0 && len(a.opts.HMACSecret) > 0 {
+			secrets = append(secrets, a.opts.HMACSecret)
+		}
+		for _, secret := range secrets {
+			mac := hmac.New(sha256.New, secret.([]byte))
+			mac.Write([]byte(signed)) // nolint:errcheck
+			if hmac.Equal(mac.Sum(nil), signature) {
+				return true
+			}
+		}
+	case "RS256":
+		keys := a.keys(h.Kid, func(key interface{}) bool { _, ok := key.(*rsa.PublicKey); return ok })
+		for _, key := range keys {
+			if rsa.VerifyPKCS1v15(key.(*rsa.PublicKey), crypto.SHA256, digest[:], signature) == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// keys returns the key with id kid if it is of the right kind, or every key of the right
+// kind when kid is empty. A kid the set doesn't know falls back to its key without one,
+// which is how a single configured key is stored.
+func (a *Authenticator) keys(kid string, kind func(key interface{}) bool) []interface{} {
+	if kid != "" {
+		if key, ok := a.opts.Keys[kid]; ok {
+			if kind(key) {
+				return []interface{}{key}
+			}
+			return nil
+		}
+		if key, ok := a.opts.Keys[""]; ok && kind(key) {
+			return []interface{}{key}
+		}
+		return nil
+	}
+
+	var keys []interface{}
+	for _, key := range a.opts.Keys {
+		if kind(key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (a *Authenticator) checkClaims(c claims) error {
+	now := a.opts.Now()
+	if c.Subject == "" {
+		return fmt.Errorf("%w: token has no subject", ErrInvalidCredentials)
+	}
+	// tokens that never expire can't be taken back, so they are not accepted at all
+	if c.ExpiresAt == nil {
+		return fmt.Errorf("%w: token has no expiry", ErrInvalidCredentials)
+	}
+	exp, err := c.ExpiresAt.Float64()
+	if err != nil || !now.Before(unix(exp).Add(a.opts.Leeway)) {
+		return fmt.Errorf("%w: token expired", ErrInvalidCredentials)
+	}
+	if c.NotBefore != nil {
+		nbf, err := c.NotBefore.Float64()
+		if err != nil || now.Add(a.opts.Leeway).Before(unix(nbf)) {
+			return fmt.Errorf("%w: token not valid yet", ErrInvalidCredentials)
+		}
+	}
+	if a.opts.Issuer != "" && c.Issuer != a.opts.Issuer {
+		return fmt.Errorf("%w: unexpected token issuer", ErrInvalidCredentials)
+	}
+	if a.opts.Audience != "" && !c.Audience.contains(a.opts.Audience) {
+		return fmt.Errorf("%w: token is not meant for this service", ErrInvalidCredentials)
+	}
+	return nil
+}
+
+// unix is the time of a NumericDate, which may have a fraction of a second.
+func unix(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
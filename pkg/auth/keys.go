@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// KeySet holds token verification keys by key id: *rsa.PublicKey for RS256 and []byte
+// for HS256. A token without a kid is tried against every key of its algorithm.
+type KeySet map[string]interface{}
+
+// jwks is a JSON Web Key Set, RFC 7517.
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		K   string `json:"k"`
+	} `json:"keys"`
+}
+
+// LoadJWKS reads a local JWKS file. RSA keys verify RS256 tokens and symmetric ("oct")
+// keys HS256 ones; keys meant for encryption are skipped.
+func LoadJWKS(path string) (KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJWKS(data)
+}
+
+// ParseJWKS is LoadJWKS for the contents of the file.
+func ParseJWKS(data []byte) (KeySet, error) {
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("auth: invalid JWKS: %w", err)
+	}
+
+	keys := KeySet{}
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Kty {
+		case "RSA":
+			n, err := base64.RawURLEncoding.DecodeString(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("auth: JWKS key %d: invalid n: %w", i, err)
+			}
+			e, err := base64.RawURLEncoding.DecodeString(k.E)
+			if err != nil {
+				return nil, fmt.Errorf("auth: JWKS key %d: invalid e: %w", i, err)
+			}
+			exponent := new(big.Int).SetBytes(e)
+			if !exponent.IsInt64() || exponent.Int64() < 2 || exponent.Int64() > 1<<31-1 {
+				return nil, fmt.Errorf("auth: JWKS key %d: invalid exponent", i)
+			}
+			keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(k.K)
+			if err != nil {
+				return nil, fmt.Errorf("auth: JWKS key %d: invalid k: %w", i, err)
+			}
+			keys[k.Kid] = secret
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("auth: JWKS holds no signing keys")
+	}
+	return keys, nil
+}
+
+// LoadRSAPublicKey reads a PEM encoded RSA public key, in PKIX or PKCS #1 form.
+func LoadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("auth: %s holds no PEM block", path)
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("auth: %s: %w", path, err)
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("auth: %s is not an RSA public key", path)
+	}
+	return key, nil
+}
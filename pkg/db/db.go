@@ -9,15 +9,19 @@ import (
 	_ "github.com/lib/pq"
 )
 
-func ConnectToDB(cfg config.Config) (*sqlx.DB, error) {
-	psqlString := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+// ConnString is the pq connection string of the database cfg names.
+func ConnString(cfg config.Config) string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable timezone=UTC",
 		cfg.PostgresHost,
 		cfg.PostgresPort,
 		cfg.PostgresUser,
 		cfg.PostgresPassword,
 		cfg.PostgresDatabase)
+}
 
-	connDb, err := sqlx.Connect("postgres", psqlString)
+// ConnectToDB opens a pool whose sessions run in UTC, so timestamps without an offset mean UTC.
+func ConnectToDB(cfg config.Config) (*sqlx.DB, error) {
+	connDb, err := sqlx.Connect("postgres", ConnString(cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -26,14 +30,7 @@ func ConnectToDB(cfg config.Config) (*sqlx.DB, error) {
 }
 
 func ConnectDBForSuite(cfg config.Config) (*sqlx.DB, func()) {
-	psqlString := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
-		cfg.PostgresHost,
-		cfg.PostgresPort,
-		cfg.PostgresUser,
-		cfg.PostgresPassword,
-		cfg.PostgresDatabase)
-
-	connDb, err := sqlx.Connect("postgres", psqlString)
+	connDb, err := sqlx.Connect("postgres", ConnString(cfg))
 	if err != nil {
 		panic(err)
 	}
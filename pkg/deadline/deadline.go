@@ -0,0 +1,198 @@
+// Package deadline parses the deadlines clients send: ISO-8601 dates and times, RFC3339
+// timestamps and relative expressions like +3d, tomorrow or next friday.
+package deadline
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats describes the accepted input, for error messages.
+const Formats = "a date like 2006-01-02, an RFC3339 timestamp, or an expression like +3d, tomorrow or next friday"
+
+// isoLayouts are the ISO-8601 forms without an offset; they are read in the parser's location.
+var isoLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+}
+
+var (
+	// offsetExpr matches +3d, -2w, +90m, + 4 h.
+	offsetExpr = regexp.MustCompile(`^([+-])\s*(\d+)\s*(m|min|h|d|w)$`)
+	// inExpr matches in 3 days, in 1 week, in 2 hours.
+	inExpr = regexp.MustCompile(`^in\s+(\d+)\s+(minute|hour|day|week)s?$`)
+	// numericDate matches 12.25.2021 or 25/12/2021, whose field order is a guess.
+	numericDate = regexp.MustCompile(`^(\d{1,2})[./](\d{1,2})[./](\d{4})$`)
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// Parser parses deadlines relative to Now and in Location, which is used for input that
+// carries no offset. Dates without a time of day mean midnight at their start.
+type Parser struct {
+	Location *time.Location
+	Now      func() time.Time
+}
+
+// NewParser returns a parser for the IANA time zone name, UTC when it is empty.
+func NewParser(timeZone string) (Parser, error) {
+	p := Parser{Location: time.UTC, Now: time.Now}
+	if timeZone == "" {
+		return p, nil
+	}
+
+	return p.In(timeZone)
+}
+
+// In returns a copy of p that reads input in the IANA time zone name.
+func (p Parser) In(timeZone string) (Parser, error) {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return Parser{}, fmt.Errorf("unknown time zone %q", timeZone)
+	}
+
+	p.Location = loc
+	return p, nil
+}
+
+// Parse returns the instant value stands for. Numeric dates whose day and month could
+// be swapped, like 01.12.2021, are rejected rather than guessed.
+func (p Parser) Parse(value string) (time.Time, error) {
+	s := strings.ToLower(strings.TrimSpace(value))
+	if s == "" {
+		return time.Time{}, fmt.Errorf("must be %s", Formats)
+	}
+
+	upper := strings.ToUpper(s)
+	if t, err := time.Parse(time.RFC3339Nano, upper); err == nil {
+		return t, nil
+	}
+	for _, layout := range isoLayouts {
+		if t, err := time.ParseInLocation(layout, upper, p.location()); err == nil {
+			return t, nil
+		}
+	}
+
+	if t, ok := p.relative(s); ok {
+		return t, nil
+	}
+
+	if m := numericDate.FindStringSubmatch(s); m != nil {
+		return p.numericDate(value, m[1], m[2], m[3])
+	}
+
+	return time.Time{}, fmt.Errorf("can't read %q, must be %s", value, Formats)
+}
+
+func (p Parser) relative(s string) (time.Time, bool) {
+	now := p.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch s {
+	case "now":
+		return now, true
+	case "today":
+		return today, true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	}
+
+	if m := offsetExpr.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[2])
+		if err != nil {
+			return time.Time{}, false
+		}
+		if m[1] == "-" {
+			n = -n
+		}
+		return shift(now, n, m[3]), true
+	}
+
+	if m := inExpr.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, false
+		}
+		return shift(now, n, m[2][:1]), true
+	}
+
+	// "friday" and "next friday" both mean the first Friday after today.
+	if day, ok := weekdays[strings.TrimPrefix(s, "next ")]; ok {
+		days := (int(day) - int(today.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return today.AddDate(0, 0, days), true
+	}
+
+	return time.Time{}, false
+}
+
+// shift moves t by n units: m(in)utes and h(ours) are exact durations, d(ays) and w(eeks)
+// are calendar days, so they keep the time of day across daylight saving changes.
+func shift(t time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "m", "min":
+		return t.Add(time.Duration(n) * time.Minute)
+	case "h":
+		return t.Add(time.Duration(n) * time.Hour)
+	case "w":
+		return t.AddDate(0, 0, 7*n)
+	}
+	return t.AddDate(0, 0, n)
+}
+
+// numericDate reads a dotted or slashed date whose field order is known only when
+// one of the first two fields can't be a month.
+func (p Parser) numericDate(value, first, second, year string) (time.Time, error) {
+	a, _ := strconv.Atoi(first)
+	b, _ := strconv.Atoi(second)
+	y, _ := strconv.Atoi(year)
+
+	var month, day int
+	switch {
+	case a == b || a <= 12 && b > 12:
+		month, day = a, b
+	case a > 12 && b <= 12:
+		month, day = b, a
+	case a <= 12 && b <= 12:
+		return time.Time{}, fmt.Errorf("%q is ambiguous, day and month could be swapped; write it as YYYY-MM-DD", value)
+	default:
+		return time.Time{}, fmt.Errorf("%q is not a date", value)
+	}
+
+	t := time.Date(y, time.Month(month), day, 0, 0, 0, 0, p.location())
+	if t.Month() != time.Month(month) || t.Day() != day {
+		return time.Time{}, fmt.Errorf("%q is not a date", value)
+	}
+
+	return t, nil
+}
+
+func (p Parser) location() *time.Location {
+	if p.Location == nil {
+		return time.UTC
+	}
+	return p.Location
+}
+
+func (p Parser) now() time.Time {
+	if p.Now == nil {
+		return time.Now().In(p.location())
+	}
+	return p.Now().In(p.location())
+}
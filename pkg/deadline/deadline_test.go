@@ -0,0 +1,86 @@
+package deadline
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParser_Parse(t *testing.T) {
+	tashkent, err := time.LoadLocation("Asia/Tashkent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Wednesday, 2021-12-22 10:30 in Tashkent.
+	now := time.Date(2021, 12, 22, 10, 30, 0, 0, tashkent)
+	p := Parser{Location: tashkent, Now: func() time.Time { return now }}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "date", input: "2021-12-25", want: time.Date(2021, 12, 25, 0, 0, 0, 0, tashkent)},
+		{name: "date and time", input: "2021-12-25 18:00", want: time.Date(2021, 12, 25, 18, 0, 0, 0, tashkent)},
+		{name: "ISO date and time", input: "2021-12-25T18:00:05", want: time.Date(2021, 12, 25, 18, 0, 5, 0, tashkent)},
+		{name: "RFC3339 keeps its offset", input: "2021-12-25T18:00:00Z", want: time.Date(2021, 12, 25, 18, 0, 0, 0, time.UTC)},
+		{name: "RFC3339 lower case", input: "2021-12-25t18:00:00z", want: time.Date(2021, 12, 25, 18, 0, 0, 0, time.UTC)},
+		{name: "today", input: "today", want: time.Date(2021, 12, 22, 0, 0, 0, 0, tashkent)},
+		{name: "tomorrow", input: " Tomorrow ", want: time.Date(2021, 12, 23, 0, 0, 0, 0, tashkent)},
+		{name: "days ahead", input: "+3d", want: now.AddDate(0, 0, 3)},
+		{name: "weeks back", input: "-2w", want: now.AddDate(0, 0, -14)},
+		{name: "hours ahead", input: "+4h", want: now.Add(4 * time.Hour)},
+		{name: "minutes ahead", input: "+90min", want: now.Add(90 * time.Minute)},
+		{name: "in days", input: "in 2 days", want: now.AddDate(0, 0, 2)},
+		{name: "weekday", input: "friday", want: time.Date(2021, 12, 24, 0, 0, 0, 0, tashkent)},
+		{name: "next weekday", input: "next fri", want: time.Date(2021, 12, 24, 0, 0, 0, 0, tashkent)},
+		{name: "same weekday is a week away", input: "next wednesday", want: time.Date(2021, 12, 29, 0, 0, 0, 0, tashkent)},
+		{name: "month first dotted", input: "12.25.2021", want: time.Date(2021, 12, 25, 0, 0, 0, 0, tashkent)},
+		{name: "day first slashed", input: "25/12/2021", want: time.Date(2021, 12, 25, 0, 0, 0, 0, tashkent)},
+		{name: "same day and month", input: "05.05.2021", want: time.Date(2021, 5, 5, 0, 0, 0, 0, tashkent)},
+		{name: "ambiguous", input: "01.12.2021", wantErr: true},
+		{name: "no month", input: "13.13.2021", wantErr: true},
+		{name: "no such day", input: "02.30.2021", wantErr: true},
+		{name: "invalid ISO date", input: "2021-02-30", wantErr: true},
+		{name: "empty", input: " ", wantErr: true},
+		{name: "unsupported", input: "someday", wantErr: true},
+		{name: "unsupported unit", input: "+3y", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := p.Parse(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+			if !tc.wantErr && !got.Equal(tc.want) {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewParser(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantZone string
+		wantErr  bool
+	}{
+		{name: "default", input: "", wantZone: "UTC"},
+		{name: "named zone", input: "Asia/Tashkent", wantZone: "Asia/Tashkent"},
+		{name: "unknown zone", input: "Mars/Olympus", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewParser(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+			if !tc.wantErr && p.Location.String() != tc.wantZone {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.wantZone, p.Location)
+			}
+		})
+	}
+}
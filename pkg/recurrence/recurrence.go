@@ -0,0 +1,328 @@
+// Package recurrence parses and evaluates the subset of iCalendar RRULEs (RFC 5545)
+// recurring tasks use: FREQ=DAILY, WEEKLY or MONTHLY with INTERVAL, BYDAY, UNTIL and COUNT.
+package recurrence
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a rule.
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+// maxInterval keeps Next from searching far past any useful schedule.
+const maxInterval = 1000
+
+// maxSteps bounds the search for an occurrence; a rule that finds none within it has ended.
+const maxSteps = 1000
+
+var dayNames = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// byDayExpr matches a BYDAY item: an optional ordinal and a two-letter day, like MO, 1FR or -1SU.
+var byDayExpr = regexp.MustCompile(`^([+-]?\d)?([A-Z]{2})$`)
+
+// Day is one BYDAY item. Ordinal is the nth such weekday of the month, counted from
+// the end when negative, or 0 for every one of them.
+type Day struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+func (d Day) String() string {
+	name := strings.ToUpper(d.Weekday.String()[:2])
+	if d.Ordinal == 0 {
+		return name
+	}
+	return strconv.Itoa(d.Ordinal) + name
+}
+
+// Rule is a parsed recurrence rule. A zero Until and Count mean the rule never ends.
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []Day
+	Until    time.Time
+	// UntilDate is set when UNTIL was a date, which ends the rule after that whole day
+	// in the occurrences' time zone.
+	UntilDate bool
+	Count     int
+}
+
+// Parse reads a rule like FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10. An RRULE: prefix is allowed.
+func Parse(value string) (Rule, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	s = strings.TrimPrefix(s, "RRULE:")
+	if s == "" {
+		return Rule{}, fmt.Errorf("is empty")
+	}
+
+	r := Rule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return Rule{}, fmt.Errorf("%q is not a NAME=VALUE part", part)
+		}
+		name, val := kv[0], kv[1]
+		if seen[name] {
+			return Rule{}, fmt.Errorf("%s is given twice", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq = Frequency(val)
+			if r.Freq != Daily && r.Freq != Weekly && r.Freq != Monthly {
+				err = fmt.Errorf("FREQ must be DAILY, WEEKLY or MONTHLY, got %s", val)
+			}
+		case "INTERVAL":
+			r.Interval, err = positive(name, val)
+			if err == nil && r.Interval > maxInterval {
+				err = fmt.Errorf("INTERVAL must be at most %d", maxInterval)
+			}
+		case "COUNT":
+			r.Count, err = positive(name, val)
+		case "UNTIL":
+			r.Until, r.UntilDate, err = parseUntil(val)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(val)
+		default:
+			err = fmt.Errorf("%s is not supported", name)
+		}
+		if err != nil {
+			return Rule{}, err
+		}
+	}
+
+	if r.Freq == "" {
+		return Rule{}, fmt.Errorf("FREQ is required")
+	}
+	if seen["COUNT"] && seen["UNTIL"] {
+		return Rule{}, fmt.Errorf("COUNT and UNTIL can't be combined")
+	}
+	for _, d := range r.ByDay {
+		if d.Ordinal != 0 && r.Freq != Monthly {
+			return Rule{}, fmt.Errorf("BYDAY ordinals like %s are only supported with FREQ=MONTHLY", d)
+		}
+	}
+
+	return r, nil
+}
+
+func positive(name, val string) (int, error) {
+	n, err := strconv.Atoi(val)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%s must be a positive number, got %s", name, val)
+	}
+	return n, nil
+}
+
+func parseUntil(val string) (time.Time, bool, error) {
+	if t, err := time.Parse("20060102", val); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse("20060102T150405Z", val); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("UNTIL must be a date like 20060102 or a UTC time like 20060102T150405Z, got %s", val)
+}
+
+func parseByDay(val string) ([]Day, error) {
+	var days []Day
+	for _, item := range strings.Split(val, ",") {
+		m := byDayExpr.FindStringSubmatch(item)
+		if m == nil {
+			return nil, fmt.Errorf("BYDAY item %q is not a day like MO or 1FR", item)
+		}
+		weekday, ok := dayNames[m[2]]
+		if !ok {
+			return nil, fmt.Errorf("BYDAY item %q is not a day like MO or 1FR", item)
+		}
+		var ordinal int
+		if m[1] != "" {
+			ordinal, _ = strconv.Atoi(m[1])
+			if ordinal == 0 || ordinal < -5 || ordinal > 5 {
+				return nil, fmt.Errorf("BYDAY ordinal of %q must be between -5 and 5 and not 0", item)
+			}
+		}
+		days = append(days, Day{Ordinal: ordinal, Weekday: weekday})
+	}
+	return days, nil
+}
+
+// String renders r in a canonical form, the one stored with tasks.
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	switch {
+	case r.Count > 0:
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	case r.UntilDate:
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+	case !r.Until.IsZero():
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the occurrence after prev, which is occurrence number n of the series,
+// counting from 1. It keeps the wall clock time of prev in prev's location and
+// reports false once COUNT or UNTIL ends the series.
+func (r Rule) Next(prev time.Time, n int) (time.Time, bool) {
+	if r.Count > 0 && n >= r.Count {
+		return time.Time{}, false
+	}
+
+	var next time.Time
+	var ok bool
+	switch r.Freq {
+	case Daily:
+		next, ok = r.nextDaily(prev)
+	case Weekly:
+		next, ok = r.nextWeekly(prev)
+	case Monthly:
+		next, ok = r.nextMonthly(prev)
+	}
+	if !ok || r.ended(next) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+func (r Rule) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+func (r Rule) ended(t time.Time) bool {
+	if r.Until.IsZero() {
+		return false
+	}
+	if r.UntilDate {
+		y, m, d := r.Until.Date()
+		return !t.Before(time.Date(y, m, d+1, 0, 0, 0, 0, t.Location()))
+	}
+	return t.After(r.Until)
+}
+
+// onDay returns the day y-m-d at the wall clock time of clock.
+func onDay(clock time.Time, y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), clock.Location())
+}
+
+func (r Rule) hasWeekday(w time.Weekday) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, d := range r.ByDay {
+		if d.Weekday == w {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Rule) nextDaily(prev time.Time) (time.Time, bool) {
+	y, m, d := prev.Date()
+	for i := 1; i <= maxSteps; i++ {
+		next := onDay(prev, y, m, d+i*r.interval())
+		if r.hasWeekday(next.Weekday()) {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// nextWeekly looks for a BYDAY later in prev's week, then in the first day of the week
+// INTERVAL weeks on. Weeks start on Monday, the RFC 5545 default.
+func (r Rule) nextWeekly(prev time.Time) (time.Time, bool) {
+	y, m, d := prev.Date()
+	if len(r.ByDay) == 0 {
+		return onDay(prev, y, m, d+7*r.interval()), true
+	}
+
+	offset := (int(prev.Weekday()) + 6) % 7 // days since Monday
+	monday := d - offset
+	for i := offset + 1; i < 7; i++ {
+		if next := onDay(prev, y, m, monday+i); r.hasWeekday(next.Weekday()) {
+			return next, true
+		}
+	}
+	for i := 0; i < 7; i++ {
+		if next := onDay(prev, y, m, monday+7*r.interval()+i); r.hasWeekday(next.Weekday()) {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// nextMonthly looks for a matching day later in prev's month, then in every INTERVALth
+// month after it. Months without the day, like the 31st of April, are skipped.
+func (r Rule) nextMonthly(prev time.Time) (time.Time, bool) {
+	y, m, d := prev.Date()
+	for _, day := range r.monthDays(y, m, d) {
+		if day > d {
+			return onDay(prev, y, m, day), true
+		}
+	}
+	for i := 1; i <= maxSteps; i++ {
+		first := time.Date(y, m+time.Month(i*r.interval()), 1, 0, 0, 0, 0, time.UTC)
+		if days := r.monthDays(first.Year(), first.Month(), d); len(days) > 0 {
+			return onDay(prev, first.Year(), first.Month(), days[0]), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// monthDays returns the days of month y-m the rule falls on, in order: those matching
+// BYDAY, or dayOfMonth when there is no BYDAY.
+func (r Rule) monthDays(y int, m time.Month, dayOfMonth int) []int {
+	length := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if len(r.ByDay) == 0 {
+		if dayOfMonth > length {
+			return nil
+		}
+		return []int{dayOfMonth}
+	}
+
+	var days []int
+	for day := 1; day <= length; day++ {
+		weekday := time.Date(y, m, day, 0, 0, 0, 0, time.UTC).Weekday()
+		for _, d := range r.ByDay {
+			if d.Weekday != weekday {
+				continue
+			}
+			nth, nthFromEnd := (day-1)/7+1, -((length-day)/7 + 1)
+			if d.Ordinal == 0 || d.Ordinal == nth || d.Ordinal == nthFromEnd {
+				days = append(days, day)
+				break
+			}
+		}
+	}
+	return days
+}
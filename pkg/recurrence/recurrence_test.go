@@ -0,0 +1,100 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "daily", input: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "prefix and lower case", input: "rrule:freq=weekly;byday=mo,we", want: "FREQ=WEEKLY;BYDAY=MO,WE"},
+		{name: "interval and count", input: "FREQ=WEEKLY;INTERVAL=2;COUNT=10", want: "FREQ=WEEKLY;INTERVAL=2;COUNT=10"},
+		{name: "interval of one is implied", input: "FREQ=DAILY;INTERVAL=1", want: "FREQ=DAILY"},
+		{name: "until date", input: "FREQ=MONTHLY;UNTIL=20220630", want: "FREQ=MONTHLY;UNTIL=20220630"},
+		{name: "until time", input: "FREQ=MONTHLY;UNTIL=20220630T120000Z", want: "FREQ=MONTHLY;UNTIL=20220630T120000Z"},
+		{name: "monthly ordinals", input: "FREQ=MONTHLY;BYDAY=1MO,-1FR", want: "FREQ=MONTHLY;BYDAY=1MO,-1FR"},
+		{name: "empty", input: " ", wantErr: true},
+		{name: "no freq", input: "INTERVAL=2", wantErr: true},
+		{name: "yearly", input: "FREQ=YEARLY", wantErr: true},
+		{name: "zero interval", input: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "count and until", input: "FREQ=DAILY;COUNT=2;UNTIL=20220101", wantErr: true},
+		{name: "unsupported part", input: "FREQ=DAILY;BYHOUR=9", wantErr: true},
+		{name: "repeated part", input: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+		{name: "bad day", input: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "weekly ordinal", input: "FREQ=WEEKLY;BYDAY=1MO", wantErr: true},
+		{name: "ordinal out of range", input: "FREQ=MONTHLY;BYDAY=6MO", wantErr: true},
+		{name: "no value", input: "FREQ=", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+			if !tc.wantErr && got.String() != tc.want {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(y int, m time.Month, d, hour int) time.Time {
+		return time.Date(y, m, d, hour, 0, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		name   string
+		rule   string
+		prev   time.Time
+		n      int
+		want   time.Time
+		wantOK bool
+	}{
+		{name: "daily", rule: "FREQ=DAILY", prev: at(2021, 12, 31, 9), n: 1, want: at(2022, 1, 1, 9), wantOK: true},
+		{name: "every other day", rule: "FREQ=DAILY;INTERVAL=2", prev: at(2021, 12, 22, 9), n: 1, want: at(2021, 12, 24, 9), wantOK: true},
+		{name: "weekdays", rule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", prev: at(2021, 12, 24, 9), n: 1, want: at(2021, 12, 27, 9), wantOK: true},
+		{name: "weekly", rule: "FREQ=WEEKLY", prev: at(2021, 12, 22, 9), n: 1, want: at(2021, 12, 29, 9), wantOK: true},
+		{name: "later in the week", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", prev: at(2021, 12, 20, 9), n: 1, want: at(2021, 12, 24, 9), wantOK: true},
+		{name: "skips to the interval week", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", prev: at(2021, 12, 24, 9), n: 2, want: at(2022, 1, 3, 9), wantOK: true},
+		{name: "sunday ends the week", rule: "FREQ=WEEKLY;BYDAY=MO,SU", prev: at(2021, 12, 20, 9), n: 1, want: at(2021, 12, 26, 9), wantOK: true},
+		{name: "monthly", rule: "FREQ=MONTHLY", prev: at(2021, 12, 15, 9), n: 1, want: at(2022, 1, 15, 9), wantOK: true},
+		{name: "monthly skips short months", rule: "FREQ=MONTHLY", prev: at(2022, 1, 31, 9), n: 1, want: at(2022, 3, 31, 9), wantOK: true},
+		{name: "quarterly", rule: "FREQ=MONTHLY;INTERVAL=3", prev: at(2021, 11, 1, 9), n: 1, want: at(2022, 2, 1, 9), wantOK: true},
+		{name: "first monday", rule: "FREQ=MONTHLY;BYDAY=1MO", prev: at(2021, 12, 6, 9), n: 1, want: at(2022, 1, 3, 9), wantOK: true},
+		{name: "last friday", rule: "FREQ=MONTHLY;BYDAY=-1FR", prev: at(2021, 12, 1, 9), n: 1, want: at(2021, 12, 31, 9), wantOK: true},
+		{name: "keeps wall clock across DST", rule: "FREQ=WEEKLY", prev: at(2022, 3, 21, 9), n: 1, want: at(2022, 3, 28, 9), wantOK: true},
+		{name: "count reached", rule: "FREQ=DAILY;COUNT=3", prev: at(2021, 12, 22, 9), n: 3},
+		{name: "count not reached", rule: "FREQ=DAILY;COUNT=3", prev: at(2021, 12, 22, 9), n: 2, want: at(2021, 12, 23, 9), wantOK: true},
+		{name: "until date is inclusive", rule: "FREQ=DAILY;UNTIL=20211223", prev: at(2021, 12, 22, 9), n: 1, want: at(2021, 12, 23, 9), wantOK: true},
+		{name: "until date passed", rule: "FREQ=DAILY;UNTIL=20211222", prev: at(2021, 12, 22, 9), n: 1},
+		{name: "until time passed", rule: "FREQ=DAILY;UNTIL=20211223T070000Z", prev: at(2021, 12, 22, 9), n: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := Parse(tc.rule)
+			if err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			got, ok := rule.Next(tc.prev, tc.n)
+			if ok != tc.wantOK {
+				t.Fatalf("%s: expected ok: %v, got: %v (%v)", tc.name, tc.wantOK, ok, got)
+			}
+			if ok && !got.Equal(tc.want) {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
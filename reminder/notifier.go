@@ -0,0 +1,58 @@
+// Package reminder tells people about deadlines: a Scheduler scans for tasks whose deadline
+// is near or has passed and sends each reminder once through a Notifier.
+package reminder
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// Notification is one reminder about a task.
+type Notification struct {
+	Kind     repo.ReminderKind
+	Task     pb.Task
+	Deadline time.Time
+	SentAt   time.Time
+}
+
+// Notifier delivers notifications. A failed delivery is retried on a later scan.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Notifiers sends every notification through each of its notifiers, and fails when any of them does.
+type Notifiers []Notifier
+
+func (ns Notifiers) Notify(ctx context.Context, n Notification) error {
+	var first error
+	for _, notifier := range ns {
+		if err := notifier.Notify(ctx, n); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// LogNotifier writes notifications to the service log.
+type LogNotifier struct {
+	log l.Logger
+}
+
+// NewLogNotifier ...
+func NewLogNotifier(log l.Logger) *LogNotifier {
+	return &LogNotifier{log: log}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification Notification) error {
+	n.log.Info("reminder",
+		l.String("kind", string(notification.Kind)),
+		l.String("task_id", notification.Task.Id),
+		l.String("title", notification.Task.Title),
+		l.String("assignee", notification.Task.Assignee),
+		l.String("deadline", notification.Deadline.Format(time.RFC3339)))
+	return nil
+}
@@ -0,0 +1,191 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// Defaults for the Options left unset. defaultBatchSize is how many reminders of each
+// kind one scan sends at most; the rest go out on the following scans.
+const (
+	defaultBatchSize     = 100
+	defaultOverdueWindow = 24 * time.Hour
+	defaultMaxAttempts   = 5
+	defaultRetryDelay    = time.Minute
+	defaultMaxDelay      = time.Hour
+)
+
+// Options configure a Scheduler.
+type Options struct {
+	// Interval is the time between scans.
+	Interval time.Duration
+	// LeadTime is how long before a deadline the upcoming reminder is sent.
+	// Zero sends overdue reminders only.
+	LeadTime time.Duration
+	// OverdueWindow is how long after a deadline passed the overdue reminder is still
+	// sent, so a scheduler that starts up doesn't remind of every task ever left undone.
+	OverdueWindow time.Duration
+	// BatchSize caps the reminders of each kind sent per scan.
+	BatchSize int
+	// MaxAttempts is how often a reminder is tried before it is marked failed.
+	MaxAttempts int
+	// RetryDelay is the wait before the first retry of a reminder; it doubles with every
+	// further one.
+	RetryDelay time.Duration
+	// MaxDelay caps the wait between retries.
+	MaxDelay time.Duration
+	// Now reads the clock, time.Now when nil.
+	Now func() time.Time
+}
+
+// Scheduler periodically sends reminders about open tasks whose deadline is within
+// LeadTime or has passed. Each reminder is claimed in storage before it is sent, so
+// schedulers of several service instances don't send it twice. A failed delivery is
+// retried by a later scan once its backoff has passed, until MaxAttempts run out, and
+// meanwhile leaves the batches of the scans to the other reminders.
+type Scheduler struct {
+	reminders repo.ReminderStorageI
+	notifier  Notifier
+	logger    l.Logger
+	opts      Options
+}
+
+// NewScheduler ...
+func NewScheduler(reminders repo.ReminderStorageI, notifier Notifier, log l.Logger, opts Options) *Scheduler {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	if opts.OverdueWindow <= 0 {
+		opts.OverdueWindow = defaultOverdueWindow
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = defaultRetryDelay
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = defaultMaxDelay
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	return &Scheduler{
+		reminders: reminders,
+		notifier:  notifier,
+		logger:    log,
+		opts:      opts,
+	}
+}
+
+// Run scans right away and then every Interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Scan(ctx); err != nil {
+			s.logger.Error("reminder scan failed", l.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan sends the reminders that are due now and reports how many were delivered.
+// Failed deliveries are logged and retried by a later scan; the error is only about
+// reading or writing storage.
+func (s *Scheduler) Scan(ctx context.Context) (int, error) {
+	now := s.opts.Now()
+
+	sent, err := s.send(ctx, repo.ReminderOverdue, now.Add(-s.opts.OverdueWindow), now, now)
+	if err != nil || s.opts.LeadTime <= 0 {
+		return sent, err
+	}
+
+	upcoming, err := s.send(ctx, repo.ReminderUpcoming, now, now.Add(s.opts.LeadTime), now)
+	return sent + upcoming, err
+}
+
+// send delivers the reminders of kind for deadlines in [from, to).
+func (s *Scheduler) send(ctx context.Context, kind repo.ReminderKind, from, to, now time.Time) (int, error) {
+	tasks, err := s.reminders.Due(kind, from, to, now, s.opts.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var sent int
+	for _, task := range tasks {
+		if ctx.Err() != nil {
+			return sent, nil
+		}
+
+		deadline, err := types.TimestampFromProto(task.DeadlineTime)
+		if err != nil {
+			s.logger.Error("task has an invalid deadline", l.String("task_id", task.Id), l.Error(err))
+			continue
+		}
+
+		reminder := repo.Reminder{TaskID: task.Id, Kind: kind, Deadline: deadline}
+		attempt, err := s.reminders.Claim(reminder, now)
+		if err != nil {
+			return sent, err
+		}
+		if attempt == 0 {
+			continue // another scheduler got there first
+		}
+
+		err = s.notifier.Notify(ctx, Notification{Kind: kind, Task: task, Deadline: deadline, SentAt: now})
+		if err != nil {
+			if err := s.fail(reminder, attempt, now, err); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// fail records the failed attempt at sending reminder, to retry after its backoff or,
+// once MaxAttempts run out, never.
+func (s *Scheduler) fail(reminder repo.Reminder, attempt int, now time.Time, cause error) error {
+	fields := []l.Field{
+		l.String("task_id", reminder.TaskID),
+		l.String("kind", string(reminder.Kind)),
+		l.Int("attempt", attempt),
+		l.Error(cause),
+	}
+	if attempt >= s.opts.MaxAttempts {
+		s.logger.Error("failed to send reminder, giving up", fields...)
+		return s.reminders.Fail(reminder, time.Time{})
+	}
+
+	retryAt := now.Add(s.backoff(attempt))
+	s.logger.Warn("failed to send reminder", append(fields, l.Any("retry_at", retryAt))...)
+	return s.reminders.Fail(reminder, retryAt)
+}
+
+// backoff is the wait after the given failed attempt: RetryDelay doubled for every
+// attempt before it, at most MaxDelay.
+func (s *Scheduler) backoff(attempt int) time.Duration {
+	delay := s.opts.RetryDelay
+	for i := 1; i < attempt && delay < s.opts.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > s.opts.MaxDelay {
+		delay = s.opts.MaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,248 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/memory"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/gogo/protobuf/types"
+)
+
+// recorder is a Notifier that remembers what it was sent, and fails while err is set.
+type recorder struct {
+	mu   sync.Mutex
+	sent []Notification
+	err  error
+}
+
+func (r *recorder) Notify(ctx context.Context, n Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return r.err
+	}
+	r.sent = append(r.sent, n)
+	return nil
+}
+
+// take returns what was sent since the last call, as "kind title" strings.
+func (r *recorder) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var got []string
+	for _, n := range r.sent {
+		got = append(got, string(n.Kind)+" "+n.Task.Title)
+	}
+	r.sent = nil
+	return got
+}
+
+func newTask(t *testing.T, tasks repo.TaskStorageI, title, status string, deadline time.Time) pb.Task {
+	t.Helper()
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	task := pb.Task{Id: id.String(), Title: title, Status: status}
+	if !deadline.IsZero() {
+		task.DeadlineTime, _ = types.TimestampProto(deadline)
+	}
+	task, err = tasks.Create(task)
+	if err != nil {
+		t.Fatalf("create %s: %v", title, err)
+	}
+	return task
+}
+
+func TestScheduler_Scan(t *testing.T) {
+	now := time.Date(2021, 12, 22, 12, 0, 0, 0, time.UTC)
+	tasks := memory.NewTaskRepo()
+	newTask(t, tasks, "Overdue", "todo", now.Add(-2*time.Hour))
+	newTask(t, tasks, "Soon", "in_progress", now.Add(30*time.Minute))
+	newTask(t, tasks, "Later", "todo", now.Add(24*time.Hour))
+	newTask(t, tasks, "Done", "done", now.Add(-time.Hour))
+	newTask(t, tasks, "Someday", "todo", time.Time{})
+	newTask(t, tasks, "Forgotten", "todo", now.Add(-48*time.Hour))
+
+	notifier := &recorder{}
+	s := NewScheduler(memory.NewReminderRepo(tasks), notifier, l.New("error", "test"), Options{
+		LeadTime: time.Hour,
+		Now:      func() time.Time { return now },
+	})
+
+	tests := []struct {
+		name  string
+		setup func()
+		want  []string
+	}{
+		{name: "first scan", want: []string{"overdue Overdue", "upcoming Soon"}},
+		{name: "nothing is sent twice", want: nil},
+		{
+			name:  "failed deliveries are retried",
+			setup: func() { newTask(t, tasks, "Flaky", "todo", now.Add(-time.Minute)); notifier.err = errors.New("down") },
+			want:  nil,
+		},
+		{name: "retries wait for their backoff", setup: func() { notifier.err = nil }, want: nil},
+		{name: "retry", setup: func() { now = now.Add(time.Minute) }, want: []string{"overdue Flaky"}},
+		{name: "deadline passes", setup: func() { now = now.Add(time.Hour) }, want: []string{"overdue Soon"}},
+	}
+
+	for _, tc := range tests {
+		if tc.setup != nil {
+			tc.setup()
+		}
+		sent, err := s.Scan(context.Background())
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		got := notifier.take()
+		if sent != len(tc.want) || len(got) != len(tc.want) {
+			t.Fatalf("%s: expected: %v, got: %v (%d sent)", tc.name, tc.want, got, sent)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
+			}
+		}
+	}
+}
+
+// picky is a Notifier that fails for the tasks titled broken and sends the others to recorder.
+type picky struct {
+	recorder
+	broken string
+	tries  int
+}
+
+func (p *picky) Notify(ctx context.Context, n Notification) error {
+	if n.Task.Title == p.broken {
+		p.tries++
+		return errors.New("no such recipient")
+	}
+	return p.recorder.Notify(ctx, n)
+}
+
+func TestScheduler_Retries(t *testing.T) {
+	now := time.Date(2021, 12, 22, 12, 0, 0, 0, time.UTC)
+	tasks := memory.NewTaskRepo()
+	newTask(t, tasks, "Broken", "todo", now.Add(-3*time.Hour))
+	newTask(t, tasks, "First", "todo", now.Add(-2*time.Hour))
+	newTask(t, tasks, "Second", "todo", now.Add(-time.Hour))
+
+	notifier := &picky{broken: "Broken"}
+	s := NewScheduler(memory.NewReminderRepo(tasks), notifier, l.New("error", "test"), Options{
+		BatchSize:   1,
+		MaxAttempts: 3,
+		RetryDelay:  time.Minute,
+		MaxDelay:    2 * time.Minute,
+		Now:         func() time.Time { return now },
+	})
+
+	// a reminder waiting for its retry leaves the batch to the ones after it
+	for _, want := range [][]string{nil, {"overdue First"}, {"overdue Second"}} {
+		if _, err := s.Scan(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if got := notifier.take(); len(got) != len(want) || (len(want) > 0 && got[0] != want[0]) {
+			t.Fatalf("expected: %v, got: %v", want, got)
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		now = now.Add(time.Hour)
+		if _, err := s.Scan(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if notifier.tries != 3 {
+		t.Fatalf("expected the broken reminder to be given up on after 3 attempts, got %d", notifier.tries)
+	}
+}
+
+func TestScheduler_Backoff(t *testing.T) {
+	s := NewScheduler(nil, nil, l.New("error", "test"), Options{RetryDelay: time.Minute, MaxDelay: 5 * time.Minute})
+
+	for attempt, want := range map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 3: 4 * time.Minute, 4: 5 * time.Minute, 10: 5 * time.Minute} {
+		if got := s.backoff(attempt); got != want {
+			t.Errorf("attempt %d: expected %v, got %v", attempt, want, got)
+		}
+	}
+}
+
+func TestScheduler_SharedStorage(t *testing.T) {
+	now := time.Date(2021, 12, 22, 12, 0, 0, 0, time.UTC)
+	tasks := memory.NewTaskRepo()
+	for i := 0; i < 20; i++ {
+		newTask(t, tasks, "Overdue", "todo", now.Add(-time.Duration(i+1)*time.Minute))
+	}
+	reminders := memory.NewReminderRepo(tasks)
+
+	notifier := &recorder{}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		s := NewScheduler(reminders, notifier, l.New("error", "test"), Options{Now: func() time.Time { return now }})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := s.Scan(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := notifier.take(); len(got) != 20 {
+		t.Fatalf("expected every reminder once, got %d", len(got))
+	}
+}
+
+func TestScheduler_Run(t *testing.T) {
+	tasks := memory.NewTaskRepo()
+	newTask(t, tasks, "Overdue", "todo", time.Now().Add(-time.Hour))
+	notifier := &recorder{}
+	s := NewScheduler(memory.NewReminderRepo(tasks), notifier, l.New("error", "test"), Options{Interval: time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for len(notifier.take()) == 0 {
+		select {
+		case <-deadline:
+			t.Fatal("no reminder was sent")
+		case <-time.After(time.Millisecond):
+		}
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after ctx was done")
+	}
+}
+
+func TestNotifiers(t *testing.T) {
+	ok, failing := &recorder{}, &recorder{err: errors.New("down")}
+	err := Notifiers{failing, ok}.Notify(context.Background(), Notification{Kind: repo.ReminderOverdue, Task: pb.Task{Title: "Test"}})
+	if err == nil {
+		t.Fatal("expected the failure to be reported")
+	}
+	if got := ok.take(); len(got) != 1 {
+		t.Fatalf("expected the other notifiers to be tried, got: %v", got)
+	}
+}
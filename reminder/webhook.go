@@ -0,0 +1,83 @@
+package reminder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// defaultWebhookTimeout bounds a delivery when NewWebhookNotifier is given no client.
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookNotifier POSTs notifications as JSON to a URL. Any answer but a 2xx is a failed delivery.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier returns a notifier for url that sends with client, or with a client
+// that gives up after 10 seconds when client is nil.
+func NewWebhookNotifier(url string, client *http.Client) *WebhookNotifier {
+	if client == nil {
+		client = &http.Client{Timeout: defaultWebhookTimeout}
+	}
+	return &WebhookNotifier{url: url, client: client}
+}
+
+// WebhookPayload is the body of a webhook request.
+type WebhookPayload struct {
+	Kind   string      `json:"kind"`
+	Task   WebhookTask `json:"task"`
+	SentAt time.Time   `json:"sent_at"`
+}
+
+// WebhookTask is the task a webhook request is about.
+type WebhookTask struct {
+	ID       string    `json:"id"`
+	Assignee string    `json:"assignee"`
+	Title    string    `json:"title"`
+	Summary  string    `json:"summary"`
+	Status   string    `json:"status"`
+	Deadline time.Time `json:"deadline"`
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(WebhookPayload{
+		Kind: string(notification.Kind),
+		Task: WebhookTask{
+			ID:       notification.Task.Id,
+			Assignee: notification.Task.Assignee,
+			Title:    notification.Task.Title,
+			Summary:  notification.Task.Summary,
+			Status:   notification.Task.Status,
+			Deadline: notification.Deadline.UTC(),
+		},
+		SentAt: notification.SentAt.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	// Drain what is left of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return nil
+}
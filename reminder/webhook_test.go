@@ -0,0 +1,73 @@
+package reminder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	deadline := time.Date(2021, 12, 22, 10, 0, 0, 0, time.UTC)
+	notification := Notification{
+		Kind:     repo.ReminderOverdue,
+		Task:     pb.Task{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Assignee: "Lola", Title: "Test", Status: "todo"},
+		Deadline: deadline,
+		SentAt:   deadline.Add(time.Minute),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "accepted", status: http.StatusNoContent},
+		{name: "rejected", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got WebhookPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.Header.Get("Content-Type"))
+				}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("bad body: %v", err)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			err := NewWebhookNotifier(server.URL, nil).Notify(context.Background(), notification)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+
+			want := WebhookPayload{
+				Kind:   "overdue",
+				Task:   WebhookTask{ID: notification.Task.Id, Assignee: "Lola", Title: "Test", Status: "todo", Deadline: deadline},
+				SentAt: deadline.Add(time.Minute),
+			}
+			if got != want {
+				t.Fatalf("%s: expected: %+v, got: %+v", tc.name, want, got)
+			}
+		})
+	}
+}
+
+func TestWebhookNotifier_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	err := NewWebhookNotifier(url, nil).Notify(context.Background(), Notification{Kind: repo.ReminderUpcoming})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -5,7 +5,7 @@ CURRENT_DIR=$(pwd)
 protoc -I /usr/local/include \
        -I $GOPATH/src/github.com/gogo/protobuf/gogoproto \
        -I $CURRENT_DIR/protos/ \
-        --gofast_out=plugins=grpc:$CURRENT_DIR/genproto/ \
+        --gofast_out=plugins=grpc,Mgoogle/protobuf/field_mask.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types:$CURRENT_DIR/genproto/ \
         $CURRENT_DIR/protos/*.proto;
 
 if [[ "$OSTYPE" == "darwin"* ]]; then
@@ -0,0 +1,79 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/auth"
+	"github.com/NafisaTojiboyeva/todo-service/service"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoService_Authentication(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(auth.Options{APIKeys: []auth.APIKey{{Key: "lola-key", Subject: "lola"}}})
+	if err != nil {
+		t.Fatalf("new authenticator: %v", err)
+	}
+	client := servicetest.New(t, servicetest.Options{
+		UnaryInterceptors:  []grpc.UnaryServerInterceptor{authenticator.UnaryServerInterceptor()},
+		StreamInterceptors: []grpc.StreamServerInterceptor{authenticator.StreamServerInterceptor()},
+	}).Client
+
+	_, err = client.Create(context.Background(), &pb.Task{Assignee: "lola", Title: "Anonymous"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated without credentials, got: %v", err)
+	}
+	bad := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyHeader, "guess")
+	if _, err := client.List(bad, &pb.ListReq{Page: 1, Limit: 10}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated with an unknown key, got: %v", err)
+	}
+
+	// The authenticated caller is the actor, whatever the request claims.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyHeader, "lola-key", service.ActorHeader, "mallory")
+	task, err := client.Create(ctx, &pb.Task{Assignee: "lola", Title: "Authenticated"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	history, err := client.GetTaskHistory(ctx, &pb.TaskHistoryReq{TaskId: task.Id})
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if len(history.Changes) != 1 || history.Changes[0].Actor != "lola" {
+		t.Fatalf("expected a change by lola, got: %v", history.Changes)
+	}
+
+	stream, err := client.WatchTasks(context.Background(), &pb.WatchTasksReq{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected an unauthenticated watch to be refused, got: %v", err)
+	}
+}
+
+func TestToDoService_NoIdentity(t *testing.T) {
+	// Calls that reach the service without an identity are refused, rather than served
+	// as an admin's.
+	pass := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(ctx, req)
+	}
+	client := servicetest.New(t, servicetest.Options{
+		UnaryInterceptors: []grpc.UnaryServerInterceptor{pass},
+	}).Client
+
+	if _, err := client.Create(context.Background(), &pb.Task{Assignee: "lola", Title: "Anonymous"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("create: expected PermissionDenied, got: %v", err)
+	}
+	if _, err := client.List(context.Background(), &pb.ListReq{Page: 1, Limit: 10}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("list: expected PermissionDenied, got: %v", err)
+	}
+	if _, err := client.ListWebhooks(context.Background(), &pb.ListWebhooksReq{}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("list webhooks: expected PermissionDenied, got: %v", err)
+	}
+}
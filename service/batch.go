@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchSize caps the number of items of one batch call.
+const maxBatchSize = 1000
+
+// batch tracks the items of a batch request on their way to storage. In best-effort mode
+// rejected items get their result right away; otherwise the first rejection fails the call.
+type batch struct {
+	bestEffort bool
+	results    []*pb.BatchResult
+	// index holds the request position of every item passed on to storage.
+	index      []int
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func newBatch(n int, bestEffort bool) (*batch, error) {
+	if n > maxBatchSize {
+		return nil, invalidArgument(fieldViolation("tasks", fmt.Sprintf("must have at most %d items, got %d", maxBatchSize, n)))
+	}
+
+	return &batch{bestEffort: bestEffort, results: make([]*pb.BatchResult, n)}, nil
+}
+
+// invalid rejects item i for violations. All-or-nothing calls collect the violations of
+// every item so the client can fix them in one go.
+func (b *batch) invalid(i int, violations []*errdetails.BadRequest_FieldViolation) {
+	if b.bestEffort {
+		b.results[i] = failedResult(invalidArgument(violations...))
+		return
+	}
+
+	for _, v := range violations {
+		b.violations = append(b.violations, fieldViolation(fmt.Sprintf("tasks[%d].%s", i, v.Field), v.Description))
+	}
+}
+
+// fail rejects item i with the status err, returning the error to fail the whole call with
+// when the batch is all-or-nothing.
+func (b *batch) fail(i int, err error) error {
+	if b.bestEffort {
+		b.results[i] = failedResult(err)
+		return nil
+	}
+
+	st := status.Convert(err)
+	return status.Errorf(st.Code(), "tasks[%d]: %s", i, st.Message())
+}
+
+func (b *batch) accept(i int) {
+	b.index = append(b.index, i)
+}
+
+func (b *batch) err() error {
+	if len(b.violations) == 0 {
+		return nil
+	}
+	return invalidArgument(b.violations...)
+}
+
+// batchResp places the storage results back at the request positions of their items.
+func (s *ToDoService) batchResp(b *batch, stored []repo.BatchResult, msg string) *pb.BatchResp {
+	for j, r := range stored {
+		i := b.index[j]
+		if r.Err != nil {
+			b.results[i] = failedResult(s.toStatus(r.Err, msg))
+			continue
+		}
+		task := r.Task
+		b.results[i] = &pb.BatchResult{Task: &task}
+	}
+
+	return &pb.BatchResp{Results: b.results}
+}
+
+func failedResult(err error) *pb.BatchResult {
+	st := status.Convert(err)
+	return &pb.BatchResult{Code: int32(st.Code()), Message: st.Message()}
+}
+
+func (s *ToDoService) BatchCreate(ctx context.Context, req *pb.BatchCreateReq) (*pb.BatchResp, error) {
+	b, err := newBatch(len(req.Tasks), req.BestEffort)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make([]pb.Task, 0, len(req.Tasks))
+	for i, task := range req.Tasks {
+		if task == nil {
+			task = &pb.Task{}
+		}
+		if violations := validateTask(s.deadlines, task, nil); len(violations) > 0 {
+			b.invalid(i, violations)
+			continue
+		}
+
+		id, err := uuid.NewV4()
+		if err != nil {
+			s.logger.Error("failed while generating uuid", l.Error(err))
+			return nil, status.Error(codes.Internal, "failed generate uuid")
+		}
+		task.Id = id.String()
+		task.Owner = actor(ctx)
+		normalizeDeadline(s.deadlines, task)
+		startSeries(task)
+
+		if task.Status == "" {
+			task.Status = string(StatusTodo)
+		}
+		taskStatus, _ := ParseTaskStatus(task.Status) // already validated
+		task.Status = string(taskStatus)
+
+		accepted = append(accepted, *task)
+		b.accept(i)
+	}
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := tasks.BatchCreate(accepted, !req.BestEffort)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to create tasks")
+	}
+
+	return s.batchResp(b, stored, "failed to create task"), nil
+}
+
+func (s *ToDoService) BatchUpdate(ctx context.Context, req *pb.BatchUpdateReq) (*pb.BatchResp, error) {
+	b, err := newBatch(len(req.Tasks), req.BestEffort)
+	if err != nil {
+		return nil, err
+	}
+
+	patches := make([]repo.TaskPatch, len(req.Tasks))
+	valid := make([]bool, len(req.Tasks))
+	ids := make([]string, 0, len(req.Tasks))
+	for i, task := range req.Tasks {
+		if task == nil {
+			task = &pb.Task{}
+		}
+		fields, err := updateFields(task.UpdateMask)
+		if err != nil {
+			b.invalid(i, []*errdetails.BadRequest_FieldViolation{fieldViolation("update_mask", err.Error())})
+			continue
+		}
+
+		var v validator
+		v.id("id", task.Id)
+		v.violations = append(v.violations, validateTask(s.deadlines, task, fields)...)
+		if len(v.violations) > 0 {
+			b.invalid(i, v.violations)
+			continue
+		}
+
+		// GetMany returns the tasks under their canonical ids, which the patches are
+		// matched against.
+		id, _ := uuid.FromString(task.Id) // already validated
+		task.Id = id.String()
+		if hasField(fields, "deadline") {
+			normalizeDeadline(s.deadlines, task)
+		}
+		patches[i] = repo.TaskPatch{Task: *task, Fields: fields}
+		valid[i] = true
+		ids = append(ids, task.Id)
+	}
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, err := tasks.GetMany(ids)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to update tasks")
+	}
+	current := make(map[string]pb.Task, len(found))
+	for _, task := range found {
+		current[task.Id] = task
+	}
+
+	accepted := make([]repo.TaskPatch, 0, len(ids))
+	for i, patch := range patches {
+		if !valid[i] {
+			continue
+		}
+		if err := s.checkPatch(&patch, current); err != nil {
+			if err := b.fail(i, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		accepted = append(accepted, patch)
+		b.accept(i)
+	}
+
+	stored, err := tasks.BatchUpdate(accepted, !req.BestEffort)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to update tasks")
+	}
+	for _, result := range stored {
+		if result.Err == nil && becameDone(current[result.Task.Id].Status, result.Task.Status) {
+			s.scheduleNext(tasks, result.Task)
+		}
+	}
+
+	return s.batchResp(b, stored, "failed to update task"), nil
+}
+
+// checkPatch applies the checks of Update to one patch against the current tasks. The
+// patch is pinned to the version it was checked against, so a task changed in between
+// is reported as a conflict instead of being overwritten.
+func (s *ToDoService) checkPatch(patch *repo.TaskPatch, current map[string]pb.Task) error {
+	task, ok := current[patch.Task.Id]
+	if !ok {
+		return s.toStatus(repo.ErrNotFound, "failed to update task")
+	}
+	if patch.Task.Version != 0 && patch.Task.Version != task.Version {
+		return s.toStatus(repo.ErrConflict, "failed to update task")
+	}
+	patch.Task.Version = task.Version
+	if err := keepSeries(&patch.Task, patch.Fields, task); err != nil {
+		return err
+	}
+
+	if hasField(patch.Fields, "status") {
+		if patch.Task.Status == "" {
+			patch.Task.Status = task.Status
+		}
+		next, _ := ParseTaskStatus(patch.Task.Status) // already validated
+		if !TaskStatus(task.Status).CanTransitionTo(next) {
+			return status.Errorf(codes.FailedPrecondition, "task can't move from %s to %s", task.Status, next)
+		}
+		patch.Task.Status = string(next)
+	}
+
+	return nil
+}
+
+func (s *ToDoService) BatchDelete(ctx context.Context, req *pb.BatchDeleteReq) (*pb.BatchResp, error) {
+	b, err := newBatch(len(req.Tasks), req.BestEffort)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]pb.ByIdReq, 0, len(req.Tasks))
+	for i, item := range req.Tasks {
+		if item == nil {
+			item = &pb.ByIdReq{}
+		}
+		var v validator
+		v.id("id", item.Id)
+		if len(v.violations) > 0 {
+			b.invalid(i, v.violations)
+			continue
+		}
+
+		items = append(items, *item)
+		b.accept(i)
+	}
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := tasks.BatchDelete(items, !req.BestEffort)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to delete tasks")
+	}
+
+	return s.batchResp(b, stored, "failed to delete task"), nil
+}
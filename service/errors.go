@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus is the single place storage errors become gRPC statuses. Errors the client
+// can't do anything about are logged and reported with msg only.
+func (s *ToDoService) toStatus(err error, msg string) error {
+	var (
+		fieldErr *repo.FieldError
+		itemErr  *repo.BatchItemError
+	)
+	switch {
+	case errors.As(err, &itemErr):
+		if errors.As(itemErr.Err, &fieldErr) {
+			return invalidArgument(fieldViolation(fmt.Sprintf("tasks[%d].%s", itemErr.Index, fieldErr.Field), fieldErr.Description))
+		}
+		st := status.Convert(s.toStatus(itemErr.Err, msg))
+		return status.Errorf(st.Code(), "tasks[%d]: %s", itemErr.Index, st.Message())
+	case errors.As(err, &fieldErr):
+		return invalidArgument(fieldViolation(fieldErr.Field, fieldErr.Description))
+	case errors.Is(err, repo.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repo.ErrNotFound):
+		return status.Error(codes.NotFound, "task not found")
+	case errors.Is(err, repo.ErrConflict):
+		return status.Error(codes.Aborted, "task was changed by someone else")
+	case errors.Is(err, repo.ErrParentDeleted):
+		return status.Error(codes.FailedPrecondition, "the parent task is deleted, restore it first")
+	case errors.Is(err, repo.ErrUnavailable):
+		s.logger.Warn(msg, l.Error(err))
+		return status.Error(codes.Unavailable, "storage is unavailable, try again later")
+	}
+
+	s.logger.Error(msg, l.Error(err))
+	return status.Error(codes.Internal, msg)
+}
+
+func fieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: description}
+}
+
+// invalidArgument builds an InvalidArgument status carrying the violations as BadRequest details.
+func invalidArgument(violations ...*errdetails.BadRequest_FieldViolation) error {
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		if v.Field == "" {
+			msgs = append(msgs, v.Description)
+			continue
+		}
+		msgs = append(msgs, v.Field+": "+v.Description)
+	}
+
+	st := status.New(codes.InvalidArgument, strings.Join(msgs, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/NafisaTojiboyeva/todo-service/pkg/deadline"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoService_toStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     error
+		wantCode  codes.Code
+		wantField string
+	}{
+		{name: "not found", input: repo.ErrNotFound, wantCode: codes.NotFound},
+		{name: "conflict", input: repo.ErrConflict, wantCode: codes.Aborted},
+		{name: "wrapped unavailable", input: fmt.Errorf("%w: connection refused", repo.ErrUnavailable), wantCode: codes.Unavailable},
+		{name: "field error", input: &repo.FieldError{Field: "deadline", Description: "bad date"}, wantCode: codes.InvalidArgument, wantField: "deadline"},
+		{name: "page token", input: repo.ErrInvalidPageToken, wantCode: codes.InvalidArgument, wantField: "page_token"},
+		{name: "unknown", input: errors.New("boom"), wantCode: codes.Internal},
+		{name: "batch item", input: &repo.BatchItemError{Index: 2, Err: repo.ErrConflict}, wantCode: codes.Aborted},
+		{name: "batch item field error", input: &repo.BatchItemError{Index: 2, Err: &repo.FieldError{Field: "status", Description: "bad status"}}, wantCode: codes.InvalidArgument, wantField: "tasks[2].status"},
+	}
+
+	s := NewToDoService(nil, l.New("error", "test"), deadline.Parser{}, nil)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			st := status.Convert(s.toStatus(tc.input, "failed"))
+			if st.Code() != tc.wantCode {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.wantCode, st.Code())
+			}
+			if tc.wantField == "" {
+				return
+			}
+
+			for _, detail := range st.Details() {
+				if br, ok := detail.(*errdetails.BadRequest); ok && len(br.FieldViolations) == 1 && br.FieldViolations[0].Field == tc.wantField {
+					return
+				}
+			}
+			t.Fatalf("%s: expected a violation of %s, got: %v", tc.name, tc.wantField, st.Details())
+		})
+	}
+}
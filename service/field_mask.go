@@ -0,0 +1,77 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// patchableFields are the Task fields an update mask may name, keyed by their lower-cased path.
+var patchableFields = map[string]bool{
+	"assignee":   true,
+	"title":      true,
+	"summary":    true,
+	"deadline":   true,
+	"status":     true,
+	"recurrence": true,
+	"project_id": true,
+	"parent_id":  true,
+}
+
+// replacedFields are the fields an Update without update_mask writes: the ones Task had
+// when masks came in. The fields added since, like recurrence, project_id and parent_id,
+// keep their values unless a mask names them, so a client that doesn't know them can't
+// clear them by leaving them out.
+var replacedFields = []string{"assignee", "title", "summary", "deadline", "status"}
+
+// maskAliases maps the paths of the Timestamp forms of fields to the fields.
+var maskAliases = map[string]string{
+	"deadline_time": "deadline",
+}
+
+// maskFields returns the lower-cased, de-duplicated field names of mask, or nil when
+// mask is empty and the whole task should be written.
+func maskFields(mask *types.FieldMask) ([]string, error) {
+	if mask == nil || len(mask.Paths) == 0 {
+		return nil, nil
+	}
+
+	seen := map[string]bool{}
+	fields := make([]string, 0, len(mask.Paths))
+	for _, path := range mask.Paths {
+		field := strings.ToLower(path)
+		if alias, ok := maskAliases[field]; ok {
+			field = alias
+		}
+		if !patchableFields[field] {
+			return nil, fmt.Errorf("unknown update mask path %q", path)
+		}
+		if !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+
+	return fields, nil
+}
+
+// updateFields returns the fields an update writes: the ones its mask names, or
+// replacedFields when the mask is empty.
+func updateFields(mask *types.FieldMask) ([]string, error) {
+	fields, err := maskFields(mask)
+	if err != nil || fields != nil {
+		return fields, err
+	}
+	return replacedFields, nil
+}
+
+func hasField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
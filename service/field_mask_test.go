@@ -0,0 +1,37 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+)
+
+func TestMaskFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *types.FieldMask
+		want    []string
+		wantErr bool
+	}{
+		{name: "no mask", input: nil, want: nil},
+		{name: "empty mask", input: &types.FieldMask{}, want: nil},
+		{name: "proto field names", input: &types.FieldMask{Paths: []string{"Title", "Deadline"}}, want: []string{"title", "deadline"}},
+		{name: "duplicates", input: &types.FieldMask{Paths: []string{"title", "Title"}}, want: []string{"title"}},
+		{name: "timestamp form of deadline", input: &types.FieldMask{Paths: []string{"deadline_time", "Deadline"}}, want: []string{"deadline"}},
+		{name: "read only field", input: &types.FieldMask{Paths: []string{"CreatedAt"}}, wantErr: true},
+		{name: "unknown field", input: &types.FieldMask{Paths: []string{"owner"}}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := maskFields(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/auth"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ActorHeader is the request metadata naming who makes a change, for task history.
+const ActorHeader = "x-actor"
+
+func (s *ToDoService) GetTaskHistory(ctx context.Context, req *pb.TaskHistoryReq) (*pb.TaskHistoryResp, error) {
+	if err := validateTaskHistory(req); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history, err := tasks.History(*req)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to get task history")
+	}
+
+	return &history, nil
+}
+
+// actor is the authenticated caller, or the first ActorHeader value of a request served
+// without authentication. It is empty when there is neither.
+func actor(ctx context.Context) string {
+	if id, ok := auth.FromContext(ctx); ok && id.Subject != "" {
+		return id.Subject
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(ActorHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/service"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoService_GetTaskHistory(t *testing.T) {
+	client := servicetest.New(t, servicetest.Options{}).Client
+	as := func(actor string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), service.ActorHeader, actor)
+	}
+
+	task, err := client.Create(as("alice"), &pb.Task{Assignee: "alice", Title: "Tracked"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	_, err = client.Update(as("bob"), &pb.Task{Id: task.Id, Assignee: "bob", Deadline: "2030-01-02T10:00:00Z", UpdateMask: &types.FieldMask{Paths: []string{"assignee", "deadline"}}})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, err := client.Delete(context.Background(), &pb.ByIdReq{Id: task.Id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	history, err := client.GetTaskHistory(context.Background(), &pb.TaskHistoryReq{TaskId: task.Id})
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if history.Count != 3 || len(history.Changes) != 3 {
+		t.Fatalf("expected 3 changes, got: %v", history)
+	}
+
+	deleted, updated, created := history.Changes[0], history.Changes[1], history.Changes[2]
+	if deleted.Action != "delete" || deleted.Actor != "" {
+		t.Errorf("expected a delete by nobody in particular, got: %v", deleted)
+	}
+	if created.Action != "create" || created.Actor != "alice" {
+		t.Errorf("expected a create by alice, got: %v", created)
+	}
+	want := []*pb.FieldChange{
+		{Field: "assignee", OldValue: "alice", NewValue: "bob"},
+		{Field: "deadline", NewValue: "2030-01-02T10:00:00Z"},
+	}
+	if updated.Action != "update" || updated.Actor != "bob" || len(updated.Fields) != len(want) {
+		t.Fatalf("expected an update of assignee and deadline by bob, got: %v", updated)
+	}
+	for i, change := range updated.Fields {
+		if change.String() != want[i].String() {
+			t.Errorf("field change %d: expected %v, got %v", i, want[i], change)
+		}
+	}
+
+	page, err := client.GetTaskHistory(context.Background(), &pb.TaskHistoryReq{TaskId: task.Id, Page: 2, Limit: 2})
+	if err != nil {
+		t.Fatalf("history page: %v", err)
+	}
+	if page.Count != 3 || len(page.Changes) != 1 || page.Changes[0].Id != created.Id {
+		t.Fatalf("expected the create alone on the second page, got: %v", page)
+	}
+
+	for _, req := range []*pb.TaskHistoryReq{{}, {TaskId: "nope"}, {TaskId: task.Id, Page: -1}} {
+		_, err := client.GetTaskHistory(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%v: expected InvalidArgument, got: %v", req, err)
+		}
+	}
+}
@@ -1,22 +1,91 @@
-package service
+package service_test
 
 import (
-	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
-	"google.golang.org/grpc"
-	"log"
-	"os"
 	"testing"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+	"github.com/NafisaTojiboyeva/todo-service/storage/memory"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// fixture is a task the table tests expect to exist, created on day.
+type fixture struct {
+	day  string
+	task pb.Task
+}
+
+var (
+	seedTask = fixture{day: "2021-12-21", task: pb.Task{
+		Id:       "24465fe0-9ea1-45ce-8a7a-79c63972efe9",
+		Assignee: "Lola",
+		Title:    "Test",
+		Summary:  "Just testing create function",
+		Deadline: "2021-12-01",
+		Status:   "done",
+	}}
+	overdueTask = fixture{day: "2021-12-22", task: pb.Task{
+		Id:       "2128d9a8-bc96-4fcf-85e4-9a6e4493b1c2",
+		Assignee: "Lola",
+		Title:    "Test",
+		Summary:  "Just testing create function",
+		Deadline: "2021-12-01",
+		Status:   "done",
+	}}
+	disposableTask = fixture{day: "2021-12-21", task: pb.Task{
+		Id:       "def039c9-e169-4301-86d7-36d346d5502e",
+		Assignee: "Lola",
+		Title:    "Delete me",
+		Status:   "todo",
+	}}
 )
 
-var client pb.ToDoServiceClient
+// newClient serves ToDoService from in-memory storage holding fixtures. Once they are
+// created the storage clock stands still at midnight of today.
+func newClient(t *testing.T, today string, fixtures ...fixture) pb.ToDoServiceClient {
+	t.Helper()
 
-func TestMain(m *testing.M) {
-	conn, err := grpc.Dial("localhost:9000", grpc.WithInsecure())
+	tasks := memory.NewTaskRepo()
+	for _, f := range fixtures {
+		tasks.SetClock(day(t, f.day))
+		if _, err := tasks.Create(f.task); err != nil {
+			t.Fatalf("failed to create fixture %s: %v", f.task.Id, err)
+		}
+	}
+	tasks.SetClock(day(t, today))
+
+	return servicetest.New(t, servicetest.Options{Storage: memory.NewStorage(tasks)}).Client
+}
+
+func day(t *testing.T, value string) func() time.Time {
+	d, err := time.Parse("2006-01-02", value)
 	if err != nil {
-		log.Fatalf("failed to get connection, %v", err)
+		t.Fatalf("bad fixture day %q: %v", value, err)
 	}
 
-	client = pb.NewToDoServiceClient(conn)
+	return func() time.Time { return d }
+}
+
+// dates moves the times of task into its string fields as the days they fall on in UTC,
+// so tables can spell them out.
+func dates(t *testing.T, task *pb.Task) {
+	t.Helper()
+
+	day := func(ts *types.Timestamp) string {
+		if ts == nil {
+			return ""
+		}
+		tm, err := types.TimestampFromProto(ts)
+		if err != nil {
+			t.Fatalf("bad timestamp %v: %v", ts, err)
+		}
+		return tm.UTC().Format("2006-01-02")
+	}
 
-	os.Exit(m.Run())
+	task.Deadline, task.DeadlineTime = day(task.DeadlineTime), nil
+	task.CreatedAt, task.CreatedTime = day(task.CreatedTime), nil
+	task.UpdatedAt, task.UpdatedTime = day(task.UpdatedTime), nil
+	task.DeletedAt, task.DeletedTime = day(task.DeletedTime), nil
 }
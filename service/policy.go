@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/auth"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// WorkspaceHeader is the request metadata naming the workspace a request works in.
+// Requests that name none work in the default workspace.
+const WorkspaceHeader = "x-workspace-id"
+
+// access is what the caller of a request may do. Admins reach every task of every
+// workspace and manage webhooks and workspaces; other callers only see and change the
+// tasks they own or are assigned to, in the workspaces they are members of. Requests
+// without an identity may do nothing: when authentication is disabled, the server
+// gives them the auth.Anonymous one.
+type access struct {
+	user       string
+	admin      bool
+	workspaces []string
+	none       bool
+}
+
+func accessOf(ctx context.Context) access {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return access{none: true}
+	}
+	return access{user: id.Subject, admin: id.HasRole(auth.RoleAdmin), workspaces: id.Workspaces}
+}
+
+// member tells whether the caller may work in workspace. Callers that are members of
+// no workspace work in the default one.
+func (a access) member(workspace string) bool {
+	if a.admin {
+		return true
+	}
+	if a.none {
+		return false
+	}
+	if len(a.workspaces) == 0 {
+		return workspace == repo.DefaultWorkspaceID
+	}
+	for _, w := range a.workspaces {
+		if w == workspace {
+			return true
+		}
+	}
+	return false
+}
+
+// sees tells whether the caller may see task.
+func (a access) sees(task pb.Task) bool {
+	return a.admin || !a.none && (task.Owner == a.user || task.Assignee == a.user)
+}
+
+// workspace resolves the workspace the request of ctx works in, checking that it exists
+// and that the caller may work in it.
+func (s *ToDoService) workspace(ctx context.Context) (string, error) {
+	id := repo.DefaultWorkspaceID
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(WorkspaceHeader); len(values) > 0 && values[0] != "" {
+			parsed, err := uuid.FromString(values[0])
+			if err != nil {
+				return "", invalidArgument(fieldViolation(WorkspaceHeader, "must be a UUID"))
+			}
+			id = parsed.String()
+		}
+	}
+
+	if !accessOf(ctx).member(id) {
+		return "", status.Error(codes.PermissionDenied, "not a member of the workspace")
+	}
+	if id != repo.DefaultWorkspaceID {
+		if _, err := s.storage.Workspace().Get(id); err != nil {
+			return "", s.workspaceStatus(err, "failed to get workspace")
+		}
+	}
+	return id, nil
+}
+
+// tasks is the task repository limited to the workspace of the request and to what its
+// caller may see and change, recording the caller as the actor of the changes.
+func (s *ToDoService) tasks(ctx context.Context) (repo.TaskStorageI, error) {
+	workspace, err := s.workspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := s.storage.Task().InWorkspace(workspace)
+	if a := accessOf(ctx); !a.admin {
+		tasks = tasks.VisibleTo(a.user)
+	}
+	return tasks.WithActor(actor(ctx)), nil
+}
+
+// projects is the project repository limited to the workspace of the request. Projects
+// are shared by the members of the workspace.
+func (s *ToDoService) projects(ctx context.Context) (repo.ProjectStorageI, error) {
+	workspace, err := s.workspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.storage.Project().InWorkspace(workspace), nil
+}
+
+// requireAdmin refuses callers who are not admins. Webhooks are delivered the task
+// events of every workspace, so only admins may manage them, and workspaces. Purges
+// can't be undone, so they are for admins too.
+func requireAdmin(ctx context.Context, what string) error {
+	if !accessOf(ctx).admin {
+		return status.Errorf(codes.PermissionDenied, "only admins may manage %s", what)
+	}
+	return nil
+}
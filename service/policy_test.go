@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/auth"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoService_Ownership(t *testing.T) {
+	keys, err := auth.ParseAPIKeys("lola:lola-key,bob:bob-key,ops:ops-key:admin")
+	if err != nil {
+		t.Fatalf("parse keys: %v", err)
+	}
+	authenticator, err := auth.NewAuthenticator(auth.Options{APIKeys: keys})
+	if err != nil {
+		t.Fatalf("new authenticator: %v", err)
+	}
+	client := servicetest.New(t, servicetest.Options{
+		UnaryInterceptors:  []grpc.UnaryServerInterceptor{authenticator.UnaryServerInterceptor()},
+		StreamInterceptors: []grpc.StreamServerInterceptor{authenticator.StreamServerInterceptor()},
+	}).Client
+	as := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyHeader, key)
+	}
+	lola, bob, ops := as("lola-key"), as("bob-key"), as("ops-key")
+
+	// The creator owns a task, whatever the request claims.
+	own, err := client.Create(lola, &pb.Task{Owner: "bob", Assignee: "carol", Title: "Lola's"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if own.Owner != "lola" {
+		t.Fatalf("expected lola to own the task, got %q", own.Owner)
+	}
+	assigned, err := client.Create(bob, &pb.Task{Assignee: "lola", Title: "Assigned to lola"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	hidden, err := client.Create(bob, &pb.Task{Assignee: "bob", Title: "Bob's"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	list, err := client.List(lola, &pb.ListReq{Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if got := ids(list.Tasks); len(got) != 2 || !got[own.Id] || !got[assigned.Id] {
+		t.Fatalf("expected lola to see her own and assigned tasks, got: %v", list.Tasks)
+	}
+	if _, err := client.Get(lola, &pb.ByIdReq{Id: hidden.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected bob's task to be hidden from lola, got: %v", err)
+	}
+	if _, err := client.Update(lola, &pb.Task{Id: hidden.Id, Title: "Mine now"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected lola not to update bob's task, got: %v", err)
+	}
+	if _, err := client.Delete(lola, &pb.ByIdReq{Id: hidden.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected lola not to delete bob's task, got: %v", err)
+	}
+	if _, err := client.ChangeStatus(lola, &pb.ChangeStatusReq{Id: assigned.Id, Status: "in_progress"}); err != nil {
+		t.Fatalf("expected the assignee to change the status: %v", err)
+	}
+
+	list, err = client.List(ops, &pb.ListReq{Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if list.Count != 3 {
+		t.Fatalf("expected an admin to see every task, got: %v", list.Tasks)
+	}
+
+	if _, err := client.Delete(lola, &pb.ByIdReq{Id: own.Id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := client.Purge(lola, &pb.PurgeReq{Id: own.Id}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected purges to be for admins only, got: %v", err)
+	}
+	if _, err := client.Purge(lola, &pb.PurgeReq{DeletedBefore: "2100-01-01"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected purges to be for admins only, got: %v", err)
+	}
+	if _, err := client.Purge(ops, &pb.PurgeReq{Id: own.Id}); err != nil {
+		t.Fatalf("purge as admin: %v", err)
+	}
+	for _, ctx := range []context.Context{ops, lola} {
+		history, err := client.GetTaskHistory(ctx, &pb.TaskHistoryReq{TaskId: own.Id})
+		if err != nil {
+			t.Fatalf("history: %v", err)
+		}
+		if history.Count != 2 {
+			t.Fatalf("expected the history of the purged task to be kept, got: %v", history.Changes)
+		}
+	}
+	if history, err := client.GetTaskHistory(bob, &pb.TaskHistoryReq{TaskId: own.Id}); err != nil || history.Count != 0 {
+		t.Fatalf("expected the history of lola's task to be hidden from bob, got: %v, %v", history, err)
+	}
+
+	if _, err := client.ListWebhooks(lola, &pb.ListWebhooksReq{}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected webhooks to be for admins only, got: %v", err)
+	}
+	if _, err := client.ListWebhooks(ops, &pb.ListWebhooksReq{}); err != nil {
+		t.Fatalf("list webhooks as admin: %v", err)
+	}
+}
+
+func ids(tasks []*pb.Task) map[string]bool {
+	set := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		set[task.Id] = true
+	}
+	return set
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ToDoService) CreateProject(ctx context.Context, req *pb.Project) (*pb.Project, error) {
+	if err := validateProject(req, false); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		s.logger.Error("failed while generating uuid", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed generate uuid")
+	}
+	req.Id = id.String()
+
+	projects, err := s.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	project, err := projects.Create(*req)
+	if err != nil {
+		return nil, s.projectStatus(err, "failed to create project")
+	}
+
+	return &project, nil
+}
+
+func (s *ToDoService) GetProject(ctx context.Context, req *pb.ByIdReq) (*pb.Project, error) {
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	project, err := projects.Get(req.Id)
+	if err != nil {
+		return nil, s.projectStatus(err, "failed to get project")
+	}
+
+	return &project, nil
+}
+
+func (s *ToDoService) ListProjects(ctx context.Context, req *pb.ListProjectsReq) (*pb.ListProjectsResp, error) {
+	if err := checkPage(&req.Page, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := projects.List(*req)
+	if err != nil {
+		return nil, s.projectStatus(err, "failed to list projects")
+	}
+
+	return &list, nil
+}
+
+func (s *ToDoService) UpdateProject(ctx context.Context, req *pb.Project) (*pb.Project, error) {
+	if err := validateProject(req, true); err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	project, err := projects.Update(*req)
+	if err != nil {
+		return nil, s.projectStatus(err, "failed to update project")
+	}
+
+	return &project, nil
+}
+
+func (s *ToDoService) DeleteProject(ctx context.Context, req *pb.ByIdReq) (*pb.EmptyResp, error) {
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := projects.Delete(req.Id); err != nil {
+		return nil, s.projectStatus(err, "failed to delete project")
+	}
+
+	return &pb.EmptyResp{}, nil
+}
+
+// projectStatus is toStatus for project calls, where what can't be found is a project
+// and a conflict is a name taken in the workspace.
+func (s *ToDoService) projectStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, repo.ErrNotFound):
+		return status.Error(codes.NotFound, "project not found")
+	case errors.Is(err, repo.ErrConflict):
+		return status.Error(codes.AlreadyExists, "project name is taken")
+	case errors.Is(err, repo.ErrInUse):
+		return status.Error(codes.FailedPrecondition, "project still has tasks, move or purge them first")
+	}
+	return s.toStatus(err, msg)
+}
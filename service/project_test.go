@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoService_Projects(t *testing.T) {
+	client := servicetest.New(t, servicetest.Options{}).Client
+	ctx := context.Background()
+
+	launch, err := client.CreateProject(ctx, &pb.Project{Name: "Launch", Color: "#FF8800"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	docs, err := client.CreateProject(ctx, &pb.Project{Name: "Docs"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	if _, err := client.CreateProject(ctx, &pb.Project{Name: "Launch"}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected a taken name to be refused, got: %v", err)
+	}
+	for _, bad := range []*pb.Project{{}, {Name: "Red", Color: "red"}, {Name: "Short", Color: "#fff"}} {
+		if _, err := client.CreateProject(ctx, bad); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected %v to be refused, got: %v", bad, err)
+		}
+	}
+
+	// A project of another workspace is neither found nor usable.
+	acme, err := client.CreateWorkspace(ctx, &pb.Workspace{Name: "acme"})
+	if err != nil {
+		t.Fatalf("create workspace: %v", err)
+	}
+	if _, err := client.GetProject(inWorkspace(ctx, acme.Id), &pb.ByIdReq{Id: launch.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected the project to be hidden from acme, got: %v", err)
+	}
+	if _, err := client.Create(inWorkspace(ctx, acme.Id), &pb.Task{ProjectId: launch.Id, Assignee: "lola", Title: "Astray"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a project of another workspace to be refused, got: %v", err)
+	}
+
+	task, err := client.Create(ctx, &pb.Task{ProjectId: launch.Id, Assignee: "lola", Title: "Press release"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := client.Create(ctx, &pb.Task{Assignee: "lola", Title: "Loose"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := client.List(ctx, &pb.ListReq{Page: 1, Limit: 10, ProjectId: "launch"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a project id to be a UUID, got: %v", err)
+	}
+
+	moved, err := client.Update(ctx, &pb.Task{Id: task.Id, ProjectId: docs.Id, UpdateMask: &types.FieldMask{Paths: []string{"project_id"}}})
+	if err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	if moved.ProjectId != docs.Id || moved.Title != "Press release" {
+		t.Fatalf("expected the task moved to docs, got: %v", moved)
+	}
+	list, err := client.List(ctx, &pb.ListReq{Page: 1, Limit: 10, ProjectId: docs.Id})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if list.Count != 1 || list.Tasks[0].Id != task.Id {
+		t.Fatalf("expected the moved task in docs, got: %v", list.Tasks)
+	}
+
+	docs.Archived = true
+	if _, err := client.UpdateProject(ctx, docs); err != nil {
+		t.Fatalf("archive project: %v", err)
+	}
+	projects, err := client.ListProjects(ctx, &pb.ListProjectsReq{})
+	if err != nil {
+		t.Fatalf("list projects: %v", err)
+	}
+	if projects.Count != 1 || projects.Projects[0].Id != launch.Id {
+		t.Fatalf("expected only launch to be listed, got: %v", projects.Projects)
+	}
+	list, err = client.List(ctx, &pb.ListReq{Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if list.Count != 1 || list.Tasks[0].Title != "Loose" {
+		t.Fatalf("expected the tasks of archived docs to be hidden, got: %v", list.Tasks)
+	}
+	list, err = client.List(ctx, &pb.ListReq{Page: 1, Limit: 10, IncludeArchived: true})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if list.Count != 2 {
+		t.Fatalf("expected both tasks when archived ones are included, got: %v", list.Tasks)
+	}
+
+	if _, err := client.DeleteProject(ctx, &pb.ByIdReq{Id: docs.Id}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected a project with tasks to be kept, got: %v", err)
+	}
+	if _, err := client.DeleteProject(ctx, &pb.ByIdReq{Id: launch.Id}); err != nil {
+		t.Fatalf("delete project: %v", err)
+	}
+	if _, err := client.GetProject(ctx, &pb.ByIdReq{Id: launch.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected the deleted project not to be found, got: %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/recurrence"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/gogo/protobuf/types"
+)
+
+// startSeries makes a validated new task the first occurrence of its series when it
+// recurs. Series fields sent by the client are ignored.
+func startSeries(task *pb.Task) {
+	task.SeriesId, task.Occurrence = "", 0
+	if task.Recurrence == "" {
+		return
+	}
+
+	rule, _ := recurrence.Parse(task.Recurrence) // already validated
+	task.Recurrence = rule.String()
+	task.SeriesId = task.Id
+	task.Occurrence = 1
+}
+
+// keepSeries carries the series of current over to a validated update of it that writes
+// fields. A task that starts recurring starts a series of its own, and one that stops
+// keeps its place in the old one.
+func keepSeries(task *pb.Task, fields []string, current pb.Task) error {
+	recurs, hasDeadline := current.Recurrence != "", current.DeadlineTime != nil || current.Deadline != ""
+	if hasField(fields, "deadline") {
+		hasDeadline = task.DeadlineTime != nil || task.Deadline != ""
+	}
+	if hasField(fields, "recurrence") {
+		recurs = task.Recurrence != ""
+		task.SeriesId, task.Occurrence = current.SeriesId, current.Occurrence
+		if recurs {
+			rule, _ := recurrence.Parse(task.Recurrence) // already validated
+			task.Recurrence = rule.String()
+			if task.SeriesId == "" {
+				task.SeriesId, task.Occurrence = current.Id, 1
+			}
+		}
+	}
+	if recurs && !hasDeadline {
+		return invalidArgument(fieldViolation("recurrence", "needs a deadline to recur from"))
+	}
+
+	return nil
+}
+
+// becameDone tells whether a task moved from status from to status to was just completed,
+// which is when a recurring task gets its next occurrence.
+func becameDone(from, to string) bool {
+	return to == string(StatusDone) && from != string(StatusDone)
+}
+
+// scheduleNext creates the occurrence that follows done, a task that was just completed,
+// if it recurs. It creates it through tasks, the repository done was completed through,
+// so that history records the same actor for both. The new task's id is derived from the
+// series and its number, so completing an occurrence twice, or concurrently, still
+// creates the next one only once. The status change has already been stored, so
+// failures are logged rather than returned.
+func (s *ToDoService) scheduleNext(tasks repo.TaskStorageI, done pb.Task) {
+	if done.Recurrence == "" {
+		return
+	}
+
+	next, ok, err := s.nextOccurrence(done)
+	if err != nil {
+		s.logger.Error("failed to compute next occurrence", l.String("id", done.Id), l.Error(err))
+		return
+	}
+	if !ok {
+		s.logger.Info("recurring task series ended", l.String("series_id", next.SeriesId))
+		return
+	}
+
+	_, err = tasks.Create(next)
+	if errors.Is(err, repo.ErrConflict) {
+		return // already created
+	}
+	if err != nil {
+		s.logger.Error("failed to create next occurrence", l.String("id", done.Id), l.Error(err))
+	}
+}
+
+// nextOccurrence returns the task that follows done in its series, and false when the
+// series has ended. The next deadline keeps the wall clock time of done's deadline in
+// done's time zone.
+func (s *ToDoService) nextOccurrence(done pb.Task) (pb.Task, bool, error) {
+	series, occurrence := done.SeriesId, done.Occurrence
+	if series == "" {
+		series = done.Id
+	}
+	if occurrence < 1 {
+		occurrence = 1
+	}
+	next := pb.Task{SeriesId: series}
+
+	rule, err := recurrence.Parse(done.Recurrence)
+	if err != nil {
+		return next, false, err
+	}
+	p, err := taskParser(s.deadlines, &done)
+	if err != nil {
+		return next, false, err
+	}
+	deadline, err := types.TimestampFromProto(done.DeadlineTime)
+	if err != nil {
+		return next, false, err
+	}
+
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	at, ok := rule.Next(deadline.In(loc), int(occurrence))
+	if !ok {
+		return next, false, nil
+	}
+
+	seriesID, err := uuid.FromString(series)
+	if err != nil {
+		return next, false, err
+	}
+	at = at.UTC()
+	next.Id = uuid.NewV5(seriesID, strconv.FormatInt(occurrence+1, 10)).String()
+	next.Assignee = done.Assignee
+	next.Owner = done.Owner
+	next.WorkspaceId = done.WorkspaceId
+	next.ProjectId = done.ProjectId
+	next.ParentId = done.ParentId
+	next.Title = done.Title
+	next.Summary = done.Summary
+	next.Status = string(StatusTodo)
+	next.Deadline = at.Format(time.RFC3339)
+	next.DeadlineTime, _ = types.TimestampProto(at)
+	next.TimeZone = done.TimeZone
+	next.Recurrence = done.Recurrence
+	next.Occurrence = occurrence + 1
+
+	return next, true, nil
+}
@@ -0,0 +1,107 @@
+// Package servicetest runs ToDoService in-process over bufconn, so service tests need
+// neither a running binary nor a database.
+package servicetest
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/NafisaTojiboyeva/todo-service/config"
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/auth"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/deadline"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/service"
+	"github.com/NafisaTojiboyeva/todo-service/storage"
+	"github.com/NafisaTojiboyeva/todo-service/watch"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+// Options configure a test server. The zero value serves from empty in-memory storage
+// with the default config, and without interceptors serves every call as auth.Anonymous,
+// the way the server does with AUTH_DISABLED.
+type Options struct {
+	Config             *config.Config
+	Storage            storage.IStorage
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
+}
+
+// Server is a running ToDoService and a client connected to it. Nothing relays the
+// events of Storage; tests that watch tasks relay them and wake Hub.
+type Server struct {
+	Config  config.Config
+	Storage storage.IStorage
+	Hub     *watch.Hub
+	Client  pb.ToDoServiceClient
+	Conn    *grpc.ClientConn
+}
+
+// New starts a server for the duration of t.
+func New(t testing.TB, opts Options) *Server {
+	t.Helper()
+
+	cfg := config.Load()
+	if opts.Config != nil {
+		cfg = *opts.Config
+	}
+	if opts.Storage == nil {
+		opts.Storage = storage.NewStorageMemory()
+	}
+
+	deadlines, err := deadline.NewParser(cfg.DefaultTimeZone)
+	if err != nil {
+		t.Fatalf("invalid default time zone: %v", err)
+	}
+
+	if opts.UnaryInterceptors == nil && opts.StreamInterceptors == nil {
+		opts.UnaryInterceptors = []grpc.UnaryServerInterceptor{auth.DisabledUnaryServerInterceptor()}
+		opts.StreamInterceptors = []grpc.StreamServerInterceptor{auth.DisabledStreamServerInterceptor()}
+	}
+
+	log := l.New(cfg.LogLevel, "todo-service-test")
+	lis := bufconn.Listen(bufSize)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(opts.UnaryInterceptors...),
+		grpc.ChainStreamInterceptor(opts.StreamInterceptors...),
+	)
+	hub := watch.NewHub(opts.Storage.Outbox(), log, watch.Options{})
+	ctx, cancel := context.WithCancel(context.Background())
+	go hub.Run(ctx)
+	pb.RegisterToDoServiceServer(s, service.NewToDoService(opts.Storage, log, deadlines, hub))
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		s.Stop()
+		cancel()
+		t.Fatalf("failed to dial bufconn: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		s.Stop()
+		cancel()
+		_ = l.Cleanup(log)
+	})
+
+	return &Server{
+		Config:  cfg,
+		Storage: opts.Storage,
+		Hub:     hub,
+		Client:  pb.NewToDoServiceClient(conn),
+		Conn:    conn,
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TaskStatus is a task's position in the workflow, stored as-is in todos.status.
+type TaskStatus string
+
+const (
+	StatusTodo       TaskStatus = "todo"
+	StatusInProgress TaskStatus = "in_progress"
+	StatusBlocked    TaskStatus = "blocked"
+	StatusDone       TaskStatus = "done"
+	StatusCancelled  TaskStatus = "cancelled"
+)
+
+// transitions lists the statuses a task may move to from each status.
+// Finished tasks can only be reopened.
+var transitions = map[TaskStatus][]TaskStatus{
+	StatusTodo:       {StatusInProgress, StatusBlocked, StatusDone, StatusCancelled},
+	StatusInProgress: {StatusTodo, StatusBlocked, StatusDone, StatusCancelled},
+	StatusBlocked:    {StatusTodo, StatusInProgress, StatusCancelled},
+	StatusDone:       {StatusTodo},
+	StatusCancelled:  {StatusTodo},
+}
+
+// ParseTaskStatus ...
+func ParseTaskStatus(s string) (TaskStatus, error) {
+	status := TaskStatus(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := transitions[status]; !ok {
+		return "", fmt.Errorf("unknown task status %q", s)
+	}
+
+	return status, nil
+}
+
+// CanTransitionTo reports whether a task in status s may be moved to next.
+// Keeping the current status is always allowed.
+func (s TaskStatus) CanTransitionTo(next TaskStatus) bool {
+	if s == next {
+		return true
+	}
+
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
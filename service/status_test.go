@@ -0,0 +1,55 @@
+package service
+
+import "testing"
+
+func TestParseTaskStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    TaskStatus
+		wantErr bool
+	}{
+		{name: "canonical", input: "in_progress", want: StatusInProgress},
+		{name: "mixed case and spaces", input: " Done ", want: StatusDone},
+		{name: "unknown", input: "Passed", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseTaskStatus(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTaskStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from TaskStatus
+		to   TaskStatus
+		want bool
+	}{
+		{name: "start work", from: StatusTodo, to: StatusInProgress, want: true},
+		{name: "finish work", from: StatusInProgress, to: StatusDone, want: true},
+		{name: "unblock", from: StatusBlocked, to: StatusInProgress, want: true},
+		{name: "same status", from: StatusDone, to: StatusDone, want: true},
+		{name: "reopen", from: StatusCancelled, to: StatusTodo, want: true},
+		{name: "finish blocked task", from: StatusBlocked, to: StatusDone, want: false},
+		{name: "resume finished task", from: StatusDone, to: StatusInProgress, want: false},
+		{name: "cancel finished task", from: StatusDone, to: StatusCancelled, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.from.CanTransitionTo(tc.to); got != tc.want {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
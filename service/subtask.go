@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+)
+
+func (s *ToDoService) ListSubtasks(ctx context.Context, req *pb.ListSubtasksReq) (*pb.ListResp, error) {
+	if err := validateListSubtasks(req); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := tasks.ListSubtasks(*req)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to list subtasks")
+	}
+
+	return &list, nil
+}
@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoService_Subtasks(t *testing.T) {
+	client := servicetest.New(t, servicetest.Options{}).Client
+	ctx := context.Background()
+
+	launch, err := client.Create(ctx, &pb.Task{Assignee: "lola", Title: "Launch"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	draft, err := client.Create(ctx, &pb.Task{ParentId: launch.Id, Assignee: "lola", Title: "Draft"})
+	if err != nil {
+		t.Fatalf("create subtask: %v", err)
+	}
+	review, err := client.Create(ctx, &pb.Task{ParentId: draft.Id, Assignee: "lola", Title: "Review"})
+	if err != nil {
+		t.Fatalf("create subtask: %v", err)
+	}
+	if _, err := client.Create(ctx, &pb.Task{ParentId: "launch", Assignee: "lola", Title: "Bad"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a parent id to be a UUID, got: %v", err)
+	}
+
+	moveUnder := func(id, parent string) error {
+		_, err := client.Update(ctx, &pb.Task{Id: id, ParentId: parent, UpdateMask: &types.FieldMask{Paths: []string{"parent_id"}}})
+		return err
+	}
+	if err := moveUnder(launch.Id, review.Id); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a cycle to be refused, got: %v", err)
+	}
+
+	if _, err := client.ChangeStatus(ctx, &pb.ChangeStatusReq{Id: draft.Id, Status: "done"}); err != nil {
+		t.Fatalf("change status: %v", err)
+	}
+	got, err := client.Get(ctx, &pb.ByIdReq{Id: launch.Id})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.SubtasksTotal != 1 || got.SubtasksDone != 1 {
+		t.Fatalf("expected 1 of 1 subtasks done, got %d of %d", got.SubtasksDone, got.SubtasksTotal)
+	}
+
+	tree, err := client.ListSubtasks(ctx, &pb.ListSubtasksReq{ParentId: launch.Id, Recursive: true})
+	if err != nil {
+		t.Fatalf("list subtasks: %v", err)
+	}
+	if tree.Count != 2 || tree.Tasks[0].Id != draft.Id || tree.Tasks[1].Id != review.Id {
+		t.Fatalf("expected draft then review, got: %v", tree.Tasks)
+	}
+	if _, err := client.ListSubtasks(ctx, &pb.ListSubtasksReq{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a parent to be required, got: %v", err)
+	}
+
+	if _, err := client.Delete(ctx, &pb.ByIdReq{Id: launch.Id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := client.Restore(ctx, &pb.ByIdReq{Id: review.Id}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected a subtask of a deleted task not to be restored, got: %v", err)
+	}
+	if _, err := client.Restore(ctx, &pb.ByIdReq{Id: launch.Id}); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if _, err := client.Get(ctx, &pb.ByIdReq{Id: review.Id}); err != nil {
+		t.Fatalf("expected the subtasks to be restored with their parent: %v", err)
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/deadline"
 	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
 	"github.com/NafisaTojiboyeva/todo-service/storage"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+	"github.com/NafisaTojiboyeva/todo-service/watch"
 
 	"github.com/gofrs/uuid"
 	"google.golang.org/grpc/codes"
@@ -13,86 +16,288 @@ import (
 )
 
 type ToDoService struct {
-	storage storage.IStorage
-	logger  l.Logger
+	storage   storage.IStorage
+	logger    l.Logger
+	deadlines deadline.Parser
+	watcher   *watch.Hub
 }
 
-// NewToDoService ...
-func NewToDoService(storage storage.IStorage, log l.Logger) *ToDoService {
+// NewToDoService returns the service. WatchTasks streams the events of watcher, and is
+// unimplemented when watcher is nil.
+func NewToDoService(storage storage.IStorage, log l.Logger, deadlines deadline.Parser, watcher *watch.Hub) *ToDoService {
 	return &ToDoService{
-		storage: storage,
-		logger:  log,
+		storage:   storage,
+		logger:    log,
+		deadlines: deadlines,
+		watcher:   watcher,
 	}
 }
 
 func (s *ToDoService) Create(ctx context.Context, req *pb.Task) (*pb.Task, error) {
+	if err := validateCreate(s.deadlines, req); err != nil {
+		return nil, err
+	}
+
 	id, err := uuid.NewV4()
 	if err != nil {
 		s.logger.Error("failed while generating uuid", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed generate uuid")
 	}
 	req.Id = id.String()
-	task, err := s.storage.Task().Create(*req)
+	req.Owner = actor(ctx)
+	normalizeDeadline(s.deadlines, req)
+	startSeries(req)
+
+	if req.Status == "" {
+		req.Status = string(StatusTodo)
+	}
+	taskStatus, _ := ParseTaskStatus(req.Status) // already validated
+	req.Status = string(taskStatus)
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task, err := tasks.Create(*req)
 	if err != nil {
-		s.logger.Error("failed to create task", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed to create task")
+		return nil, s.toStatus(err, "failed to create task")
 	}
 
 	return &task, nil
 }
 
 func (s *ToDoService) Get(ctx context.Context, req *pb.ByIdReq) (*pb.Task, error) {
-	task, err := s.storage.Task().Get(req.GetId())
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
 	if err != nil {
-		s.logger.Error("failed to get task", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed to get task")
+		return nil, err
+	}
+	task, err := tasks.Get(req.GetId())
+	if err != nil {
+		return nil, s.toStatus(err, "failed to get task")
 	}
 
 	return &task, nil
 }
 
 func (s *ToDoService) List(ctx context.Context, req *pb.ListReq) (*pb.ListResp, error) {
-	task, count, err := s.storage.Task().List(req.Page, req.Limit)
+	if err := resolveListDates(s.deadlines, req); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := tasks.List(*req)
 	if err != nil {
-		s.logger.Error("failed to get task", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed to get task")
+		return nil, s.toStatus(err, "failed to list tasks")
 	}
 
-	return &pb.ListResp{
-		Tasks: task,
-		Count: count,
-	}, nil
+	return &list, nil
 }
 
 func (s *ToDoService) Update(ctx context.Context, req *pb.Task) (*pb.Task, error) {
-	task, err := s.storage.Task().Update(*req)
+	fields, err := updateFields(req.UpdateMask)
+	if err != nil {
+		return nil, invalidArgument(fieldViolation("update_mask", err.Error()))
+	}
+	if err := validateUpdate(s.deadlines, req, fields); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := tasks.Get(req.Id)
 	if err != nil {
-		s.logger.Error("failed to update task", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed to update task")
+		return nil, s.toStatus(err, "failed to update task")
+	}
+	if req.Version != 0 && req.Version != current.Version {
+		return nil, s.toStatus(repo.ErrConflict, "failed to update task")
+	}
+	// The checks below are made against current, so the update is pinned to its version:
+	// a task changed in between is reported as a conflict instead of being overwritten.
+	req.Version = current.Version
+	if err := keepSeries(req, fields, current); err != nil {
+		return nil, err
+	}
+
+	if hasField(fields, "deadline") {
+		normalizeDeadline(s.deadlines, req)
+	}
+	if hasField(fields, "status") {
+		if req.Status == "" {
+			req.Status = current.Status
+		}
+		next, err := ParseTaskStatus(req.Status)
+		if err != nil {
+			return nil, s.toStatus(err, "failed to update task")
+		}
+		if !TaskStatus(current.Status).CanTransitionTo(next) {
+			return nil, status.Errorf(codes.FailedPrecondition, "task can't move from %s to %s", current.Status, next)
+		}
+		req.Status = string(next)
+	}
+
+	task, err := tasks.Patch(*req, fields)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to update task")
+	}
+	if becameDone(current.Status, task.Status) {
+		s.scheduleNext(tasks, task)
 	}
 
 	return &task, nil
 }
 
 func (s *ToDoService) Delete(ctx context.Context, req *pb.ByIdReq) (*pb.EmptyResp, error) {
-	err := s.storage.Task().Delete(req.Id)
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
 	if err != nil {
-		s.logger.Error("failed to delete task", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed to delete task")
+		return nil, err
+	}
+	if err := tasks.Delete(req.Id, req.Version); err != nil {
+		return nil, s.toStatus(err, "failed to delete task")
 	}
 
 	return &pb.EmptyResp{}, nil
 }
 
+func (s *ToDoService) ChangeStatus(ctx context.Context, req *pb.ChangeStatusReq) (*pb.Task, error) {
+	if err := validateChangeStatus(req); err != nil {
+		return nil, err
+	}
+	next, _ := ParseTaskStatus(req.Status) // already validated
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := tasks.Get(req.Id)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to change task status")
+	}
+	if !TaskStatus(current.Status).CanTransitionTo(next) {
+		return nil, status.Errorf(codes.FailedPrecondition, "task can't move from %s to %s", current.Status, next)
+	}
+
+	task, err := tasks.ChangeStatus(req.Id, current.Status, string(next))
+	if err != nil {
+		return nil, s.toStatus(err, "failed to change task status")
+	}
+	if becameDone(current.Status, task.Status) {
+		s.scheduleNext(tasks, task)
+	}
+
+	return &task, nil
+}
+
 func (s *ToDoService) ListOverdue(ctx context.Context, req *pb.ByDeadlineReq) (*pb.ListResp, error) {
-	tasks, count, err := s.storage.Task().ListOverdue(req.Deadline, req.Page, req.Limit)
+	if err := resolveOverdue(s.deadlines, req); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := tasks.ListOverdue(*req)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to list overdue tasks")
+	}
+
+	return &list, nil
+}
+
+func (s *ToDoService) Restore(ctx context.Context, req *pb.ByIdReq) (*pb.Task, error) {
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task, err := tasks.Restore(req.Id)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to restore task")
+	}
+
+	return &task, nil
+}
+
+func (s *ToDoService) ListDeleted(ctx context.Context, req *pb.ListReq) (*pb.ListResp, error) {
+	if err := resolveListDates(s.deadlines, req); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := tasks.ListDeleted(*req)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to list deleted tasks")
+	}
+
+	return &list, nil
+}
+
+func (s *ToDoService) Purge(ctx context.Context, req *pb.PurgeReq) (*pb.PurgeResp, error) {
+	if err := requireAdmin(ctx, "purges"); err != nil {
+		return nil, err
+	}
+	if err := validatePurge(s.deadlines, req); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id != "" {
+		if err := tasks.Purge(req.Id); err != nil {
+			return nil, s.toStatus(err, "failed to purge task")
+		}
+		return &pb.PurgeResp{Purged: 1}, nil
+	}
+
+	cutoff, _ := s.deadlines.Parse(req.DeletedBefore) // already validated
+	purged, err := tasks.PurgeDeletedBefore(cutoff)
+	if err != nil {
+		return nil, s.toStatus(err, "failed to purge tasks")
+	}
+	s.logger.Info("purged deleted tasks", l.String("deleted_before", req.DeletedBefore), l.Any("count", purged))
+
+	return &pb.PurgeResp{Purged: purged}, nil
+}
+
+func (s *ToDoService) Search(ctx context.Context, req *pb.SearchReq) (*pb.SearchResp, error) {
+	if err := validateSearch(req); err != nil {
+		return nil, err
+	}
+	if req.Status != "" {
+		taskStatus, _ := ParseTaskStatus(req.Status) // already validated
+		req.Status = string(taskStatus)
+	}
+
+	tasks, err := s.tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := tasks.Search(*req)
 	if err != nil {
-		s.logger.Error("failed to get task", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed to get task")
+		return nil, s.toStatus(err, "failed to search tasks")
 	}
 
-	return &pb.ListResp{
-		Tasks: tasks,
-		Count: count,
-	}, nil
+	return &results, nil
 }
@@ -1,16 +1,32 @@
-package service
+package service_test
 
 import (
 	"context"
 	"errors"
 	"fmt"
-	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/NafisaTojiboyeva/todo-service/config"
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/service"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+	"github.com/NafisaTojiboyeva/todo-service/storage"
+	"github.com/NafisaTojiboyeva/todo-service/storage/memory"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestTaskService_Create(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+
 	tests := []struct {
 		name    string
 		input   pb.Task
@@ -24,15 +40,17 @@ func TestTaskService_Create(t *testing.T) {
 				Title:    "Test",
 				Summary:  "Just testing create function",
 				Deadline: "2021-12-01",
-				Status:   "Passed",
+				Status:   "done",
 			},
 			want: pb.Task{
-				Assignee:  "Lola",
-				Title:     "Test",
-				Summary:   "Just testing create function",
-				Deadline:  "2021-12-01",
-				Status:    "Passed",
-				CreatedAt: "2021-12-22",
+				Assignee:    "Lola",
+				Title:       "Test",
+				Summary:     "Just testing create function",
+				Deadline:    "2021-12-01",
+				Status:      "done",
+				CreatedAt:   "2021-12-22",
+				WorkspaceId: repo.DefaultWorkspaceID,
+				Version:     1,
 			},
 			wantErr: false,
 		},
@@ -43,15 +61,17 @@ func TestTaskService_Create(t *testing.T) {
 				Title:    "Test",
 				Summary:  "Just testing create function",
 				Deadline: "12.25.2021",
-				Status:   "Passed",
+				Status:   "done",
 			},
 			want: pb.Task{
-				Assignee:  "Abs",
-				Title:     "Test",
-				Summary:   "Just testing create function",
-				Deadline:  "2021-12-25",
-				Status:    "Passed",
-				CreatedAt: "2021-12-22",
+				Assignee:    "Abs",
+				Title:       "Test",
+				Summary:     "Just testing create function",
+				Deadline:    "2021-12-25",
+				Status:      "done",
+				CreatedAt:   "2021-12-22",
+				WorkspaceId: repo.DefaultWorkspaceID,
+				Version:     1,
 			},
 			wantErr: false,
 		},
@@ -64,15 +84,8 @@ func TestTaskService_Create(t *testing.T) {
 				t.Error("failed to create task", err)
 			}
 
-			// formatting times
-			deadline, err := time.Parse(time.RFC3339, got.GetDeadline())
-			got.Deadline = deadline.Format("2006-01-02")
-			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-			}
-			createdAt, err := time.Parse(time.RFC3339, got.GetCreatedAt())
-			got.CreatedAt = createdAt.Format("2006-01-02")
-
+			// comparing days
+			dates(t, got)
 			got.Id = ""
 			if !reflect.DeepEqual(tc.want, *got) {
 				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
@@ -82,6 +95,8 @@ func TestTaskService_Create(t *testing.T) {
 }
 
 func TestToDoService_Get(t *testing.T) {
+	client := newClient(t, "2021-12-22", seedTask)
+
 	tests := []struct {
 		name    string
 		input   pb.ByIdReq
@@ -92,12 +107,13 @@ func TestToDoService_Get(t *testing.T) {
 			name:  "successful",
 			input: pb.ByIdReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9"},
 			want: pb.Task{
-				Assignee:  "Lola",
-				Title:     "Test",
-				Summary:   "Just testing create function",
-				Deadline:  "2021-12-01",
-				Status:    "Passed",
-				CreatedAt: "2021-12-21",
+				Assignee:    "Lola",
+				Title:       "Test",
+				Summary:     "Just testing create function",
+				Deadline:    "2021-12-01",
+				Status:      "done",
+				CreatedAt:   "2021-12-21",
+				WorkspaceId: repo.DefaultWorkspaceID,
 			},
 			wantErr: false,
 		},
@@ -110,14 +126,10 @@ func TestToDoService_Get(t *testing.T) {
 				t.Error("failed to create task", err)
 			}
 
-			// formatting times
-			deadline, err := time.Parse(time.RFC3339, got.GetDeadline())
-			got.Deadline = deadline.Format("2006-01-02")
-			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-			}
-			createdAt, err := time.Parse(time.RFC3339, got.GetCreatedAt())
-			got.CreatedAt = createdAt.Format("2006-01-02")
+			// comparing days
+			dates(t, got)
+			// the table doesn't spell out versions
+			got.Version = 0
 
 			got.Id = ""
 			if !reflect.DeepEqual(tc.want, *got) {
@@ -128,6 +140,8 @@ func TestToDoService_Get(t *testing.T) {
 }
 
 func TestToDoService_List(t *testing.T) {
+	client := newClient(t, "2021-12-22", seedTask, overdueTask)
+
 	tests := []struct {
 		name    string
 		input   pb.ListReq
@@ -143,13 +157,14 @@ func TestToDoService_List(t *testing.T) {
 			want: pb.ListResp{
 				Tasks: []*pb.Task{
 					{
-						Id:        "24465fe0-9ea1-45ce-8a7a-79c63972efe9",
-						Assignee:  "Lola",
-						Title:     "Test",
-						Summary:   "Just testing create function",
-						Deadline:  "2021-12-01",
-						Status:    "Passed",
-						CreatedAt: "2021-12-21",
+						Id:          "24465fe0-9ea1-45ce-8a7a-79c63972efe9",
+						Assignee:    "Lola",
+						Title:       "Test",
+						Summary:     "Just testing create function",
+						Deadline:    "2021-12-01",
+						Status:      "done",
+						CreatedAt:   "2021-12-21",
+						WorkspaceId: repo.DefaultWorkspaceID,
 					},
 				},
 				Count: 2,
@@ -165,15 +180,10 @@ func TestToDoService_List(t *testing.T) {
 				t.Error("failed to create task", err)
 			}
 
-			// formatting times
+			// comparing days
 			for _, task := range got.Tasks {
-				deadline, err := time.Parse(time.RFC3339, task.Deadline)
-				task.Deadline = deadline.Format("2006-01-02")
-				if err != nil {
-					t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-				}
-				createdAt, err := time.Parse(time.RFC3339, task.GetCreatedAt())
-				task.CreatedAt = createdAt.Format("2006-01-02")
+				dates(t, task)
+				task.Version = 0
 			}
 
 			if !reflect.DeepEqual(tc.want, *got) {
@@ -184,6 +194,8 @@ func TestToDoService_List(t *testing.T) {
 }
 
 func TestToDoService_Update(t *testing.T) {
+	client := newClient(t, "2021-12-21", seedTask)
+
 	tests := []struct {
 		name    string
 		input   pb.Task
@@ -198,18 +210,19 @@ func TestToDoService_Update(t *testing.T) {
 				Title:     "Test",
 				Summary:   "Just testing create function",
 				Deadline:  "2021-12-05",
-				Status:    "Passed",
+				Status:    "done",
 				CreatedAt: "2021-12-21",
 			},
 			want: pb.Task{
-				Id:        "24465fe0-9ea1-45ce-8a7a-79c63972efe9",
-				Assignee:  "Lola",
-				Title:     "Test",
-				Summary:   "Just testing create function",
-				Deadline:  "2021-12-05",
-				Status:    "Passed",
-				CreatedAt: "2021-12-21",
-				UpdatedAt: "2021-12-21",
+				Id:          "24465fe0-9ea1-45ce-8a7a-79c63972efe9",
+				Assignee:    "Lola",
+				Title:       "Test",
+				Summary:     "Just testing create function",
+				Deadline:    "2021-12-05",
+				Status:      "done",
+				CreatedAt:   "2021-12-21",
+				WorkspaceId: repo.DefaultWorkspaceID,
+				UpdatedAt:   "2021-12-21",
 			},
 			wantErr: false,
 		},
@@ -222,22 +235,9 @@ func TestToDoService_Update(t *testing.T) {
 				t.Error("failed to create task", err)
 			}
 
-			// formatting times
-			deadline, err := time.Parse(time.RFC3339, got.GetDeadline())
-			got.Deadline = deadline.Format("2006-01-02")
-			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-			}
-			createdAt, err := time.Parse(time.RFC3339, got.GetCreatedAt())
-			got.CreatedAt = createdAt.Format("2006-01-02")
-			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-			}
-			updatedAt, err := time.Parse(time.RFC3339, got.GetUpdatedAt())
-			got.UpdatedAt = updatedAt.Format("2006-01-02")
-			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-			}
+			// comparing days
+			dates(t, got)
+			got.Version = 0
 
 			if !reflect.DeepEqual(tc.want, *got) {
 				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
@@ -247,6 +247,8 @@ func TestToDoService_Update(t *testing.T) {
 }
 
 func TestToDoService_Delete(t *testing.T) {
+	client := newClient(t, "2021-12-22", disposableTask)
+
 	tests := []struct {
 		name    string
 		input   pb.ByIdReq
@@ -266,7 +268,7 @@ func TestToDoService_Delete(t *testing.T) {
 			input: pb.ByIdReq{
 				Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe8",
 			},
-			want:    errors.New("rpc error: code = Internal desc = failed to delete task"),
+			want:    errors.New("rpc error: code = NotFound desc = task not found"),
 			wantErr: true,
 		},
 	}
@@ -276,7 +278,7 @@ func TestToDoService_Delete(t *testing.T) {
 			_, err := client.Delete(context.Background(), &tc.input)
 			fmt.Println(err)
 			if err != nil {
-				if !reflect.DeepEqual(tc.want, err) {
+				if tc.want == nil || tc.want.Error() != err.Error() {
 					t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, err)
 				}
 			}
@@ -286,6 +288,15 @@ func TestToDoService_Delete(t *testing.T) {
 }
 
 func TestToDoService_ListOverdue(t *testing.T) {
+	upcomingTask := fixture{day: "2021-12-22", task: pb.Task{
+		Id:       "5e3f6c1a-2b7d-4c8e-9f10-a1b2c3d4e5f6",
+		Assignee: "Lola",
+		Title:    "Not due yet",
+		Deadline: "2021-12-31",
+		Status:   "todo",
+	}}
+	client := newClient(t, "2021-12-22", overdueTask, upcomingTask)
+
 	tests := []struct {
 		name    string
 		input   pb.ByDeadlineReq
@@ -302,13 +313,14 @@ func TestToDoService_ListOverdue(t *testing.T) {
 			want: pb.ListResp{
 				Tasks: []*pb.Task{
 					{
-						Id:        "2128d9a8-bc96-4fcf-85e4-9a6e4493b1c2",
-						Assignee:  "Lola",
-						Title:     "Test",
-						Summary:   "Just testing create function",
-						Deadline:  "2021-12-01",
-						Status:    "Passed",
-						CreatedAt: "2021-12-22",
+						Id:          "2128d9a8-bc96-4fcf-85e4-9a6e4493b1c2",
+						Assignee:    "Lola",
+						Title:       "Test",
+						Summary:     "Just testing create function",
+						Deadline:    "2021-12-01",
+						Status:      "done",
+						CreatedAt:   "2021-12-22",
+						WorkspaceId: repo.DefaultWorkspaceID,
 					},
 				},
 				Count: 1,
@@ -323,15 +335,10 @@ func TestToDoService_ListOverdue(t *testing.T) {
 				t.Error("failed to create task", err)
 			}
 
-			// formatting times
+			// comparing days
 			for _, task := range got.Tasks {
-				deadline, err := time.Parse(time.RFC3339, task.Deadline)
-				task.Deadline = deadline.Format("2006-01-02")
-				if err != nil {
-					t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-				}
-				createdAt, err := time.Parse(time.RFC3339, task.GetCreatedAt())
-				task.CreatedAt = createdAt.Format("2006-01-02")
+				dates(t, task)
+				task.Version = 0
 			}
 
 			if !reflect.DeepEqual(tc.want, *got) {
@@ -340,3 +347,537 @@ func TestToDoService_ListOverdue(t *testing.T) {
 		})
 	}
 }
+
+func TestToDoService_ChangeStatus(t *testing.T) {
+	client := newClient(t, "2021-12-22", seedTask)
+
+	tests := []struct {
+		name     string
+		input    pb.ChangeStatusReq
+		want     string
+		wantCode codes.Code
+	}{
+		{
+			name:     "reopen",
+			input:    pb.ChangeStatusReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Status: "todo"},
+			want:     "todo",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "start work",
+			input:    pb.ChangeStatusReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Status: "in_progress"},
+			want:     "in_progress",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "unknown status",
+			input:    pb.ChangeStatusReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Status: "Passed"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "finish",
+			input:    pb.ChangeStatusReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Status: "done"},
+			want:     "done",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "illegal transition",
+			input:    pb.ChangeStatusReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Status: "blocked"},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := client.ChangeStatus(context.Background(), &tc.input)
+			if status.Code(err) != tc.wantCode {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.wantCode, err)
+			}
+			if err == nil && got.Status != tc.want {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got.Status)
+			}
+		})
+	}
+}
+
+func TestToDoService_UpdateWithMask(t *testing.T) {
+	client := newClient(t, "2021-12-22", seedTask)
+
+	tests := []struct {
+		name     string
+		input    pb.Task
+		want     string
+		wantCode codes.Code
+	}{
+		{
+			name: "only title",
+			input: pb.Task{
+				Id:         "24465fe0-9ea1-45ce-8a7a-79c63972efe9",
+				Title:      "Masked update",
+				UpdateMask: &types.FieldMask{Paths: []string{"Title"}},
+			},
+			want:     "Lola",
+			wantCode: codes.OK,
+		},
+		{
+			name: "unknown path",
+			input: pb.Task{
+				Id:         "24465fe0-9ea1-45ce-8a7a-79c63972efe9",
+				UpdateMask: &types.FieldMask{Paths: []string{"CreatedAt"}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := client.Update(context.Background(), &tc.input)
+			if status.Code(err) != tc.wantCode {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.wantCode, err)
+			}
+			if err == nil && got.Assignee != tc.want {
+				t.Fatalf("%s: expected assignee to stay %v, got: %v", tc.name, tc.want, got.Assignee)
+			}
+		})
+	}
+}
+
+func TestToDoService_UpdateWithoutMask(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+	ctx := context.Background()
+
+	project, err := client.CreateProject(ctx, &pb.Project{Name: "Garden"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	parent, err := client.Create(ctx, &pb.Task{Assignee: "Lola", Title: "Garden"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	task, err := client.Create(ctx, &pb.Task{
+		Assignee: "Lola", Title: "Water plants", Deadline: "2021-12-23", Recurrence: "FREQ=WEEKLY",
+		ProjectId: project.Id, ParentId: parent.Id,
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// A client that leaves out the fields added after masks came in leaves them as they are.
+	got, err := client.Update(ctx, &pb.Task{Id: task.Id, Assignee: "Bob", Title: "Water the plants", Deadline: "2021-12-24"})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if got.Assignee != "Bob" || got.Title != "Water the plants" || got.Status != "todo" {
+		t.Fatalf("expected the task to be replaced, got: %v", got)
+	}
+	if got.ProjectId != project.Id || got.ParentId != parent.Id || got.Recurrence != "FREQ=WEEKLY" || got.SeriesId != task.SeriesId {
+		t.Fatalf("expected the project, parent and recurrence to be kept, got: %v", got)
+	}
+
+	got, err = client.Update(ctx, &pb.Task{Id: task.Id, UpdateMask: &types.FieldMask{Paths: []string{"project_id", "parent_id"}}})
+	if err != nil {
+		t.Fatalf("update with mask: %v", err)
+	}
+	if got.ProjectId != "" || got.ParentId != "" {
+		t.Fatalf("expected a mask to clear the project and parent, got: %v", got)
+	}
+}
+
+func TestToDoService_UpdateConflict(t *testing.T) {
+	client := newClient(t, "2021-12-22", seedTask)
+
+	task, err := client.Get(context.Background(), &pb.ByIdReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9"})
+	if err != nil {
+		t.Fatalf("got: %v", err)
+	}
+
+	stale := task.Version
+	task.Summary = "Changed by first client"
+	if _, err = client.Update(context.Background(), task); err != nil {
+		t.Fatalf("got: %v", err)
+	}
+
+	task.Summary = "Changed by second client"
+	task.Version = stale
+	if _, err = client.Update(context.Background(), task); status.Code(err) != codes.Aborted {
+		t.Fatalf("update: expected: %v, got: %v", codes.Aborted, err)
+	}
+	_, err = client.Delete(context.Background(), &pb.ByIdReq{Id: task.Id, Version: stale})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("delete: expected: %v, got: %v", codes.Aborted, err)
+	}
+}
+
+// racingTasks is a task repository that calls race after every Get, to change the task
+// between the read and the write of a service call.
+type racingTasks struct {
+	repo.TaskStorageI
+	race func()
+}
+
+func (r racingTasks) Get(id string) (pb.Task, error) {
+	task, err := r.TaskStorageI.Get(id)
+	r.race()
+	return task, err
+}
+
+func (r racingTasks) InWorkspace(id string) repo.TaskStorageI {
+	return racingTasks{r.TaskStorageI.InWorkspace(id), r.race}
+}
+
+func (r racingTasks) WithActor(actor string) repo.TaskStorageI {
+	return racingTasks{r.TaskStorageI.WithActor(actor), r.race}
+}
+
+type racingStorage struct {
+	storage.IStorage
+	tasks racingTasks
+}
+
+func (s racingStorage) Task() repo.TaskStorageI {
+	return s.tasks
+}
+
+func TestToDoService_UpdateRace(t *testing.T) {
+	tasks := memory.NewTaskRepo()
+	task, err := tasks.Create(pb.Task{Id: "7f9d3a52-4a44-4c1b-9a6e-0c2d8f1e5b10", Assignee: "Lola", Title: "Raced", Status: "todo"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	raced := false
+	race := func() {
+		if !raced {
+			raced = true
+			if _, err := tasks.ChangeStatus(task.Id, "todo", "cancelled"); err != nil {
+				t.Fatalf("change status: %v", err)
+			}
+		}
+	}
+	client := servicetest.New(t, servicetest.Options{
+		Storage: racingStorage{IStorage: memory.NewStorage(tasks), tasks: racingTasks{tasks, race}},
+	}).Client
+
+	// Done was a valid move from todo when it was checked, but not from cancelled.
+	_, err = client.Update(context.Background(), &pb.Task{Id: task.Id, Status: "done", UpdateMask: &types.FieldMask{Paths: []string{"status"}}})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("update: expected: %v, got: %v", codes.Aborted, err)
+	}
+	got, err := tasks.Get(task.Id)
+	if err != nil || got.Status != "cancelled" {
+		t.Fatalf("expected the task to stay cancelled, got: %v, %v", got, err)
+	}
+}
+
+func TestToDoService_CreateInvalid(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+
+	_, err := client.Create(context.Background(), &pb.Task{
+		Assignee: "A very long assignee name that does not fit in the column",
+		Deadline: "someday",
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected: %v, got: %v", codes.InvalidArgument, err)
+	}
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	want := []string{"title", "assignee", "deadline"}
+	if !reflect.DeepEqual(want, fields) {
+		t.Fatalf("expected: %v, got: %v", want, fields)
+	}
+}
+
+func TestToDoService_InvalidRequests(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"get malformed id": func() error {
+			_, err := client.Get(ctx, &pb.ByIdReq{Id: "42"})
+			return err
+		},
+		"delete malformed id": func() error {
+			_, err := client.Delete(ctx, &pb.ByIdReq{Id: "42"})
+			return err
+		},
+		"list negative page": func() error {
+			_, err := client.List(ctx, &pb.ListReq{Page: -1, Limit: 10})
+			return err
+		},
+		"list overdue negative limit": func() error {
+			_, err := client.ListOverdue(ctx, &pb.ByDeadlineReq{Deadline: "2021-12-22", Page: 1, Limit: -10})
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected: %v, got: %v", name, codes.InvalidArgument, err)
+		}
+	}
+}
+
+func TestToDoService_RestoreAndPurge(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+
+	task, err := client.Create(context.Background(), &pb.Task{Assignee: "Lola", Title: "Restore me"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err = client.Delete(context.Background(), &pb.ByIdReq{Id: task.Id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err = client.Restore(context.Background(), &pb.ByIdReq{Id: task.Id}); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if _, err = client.Get(context.Background(), &pb.ByIdReq{Id: task.Id}); err != nil {
+		t.Fatalf("get restored: %v", err)
+	}
+
+	if _, err = client.Delete(context.Background(), &pb.ByIdReq{Id: task.Id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	got, err := client.Purge(context.Background(), &pb.PurgeReq{Id: task.Id})
+	if err != nil || got.Purged != 1 {
+		t.Fatalf("purge: expected: 1, got: %v, %v", got, err)
+	}
+	_, err = client.Restore(context.Background(), &pb.ByIdReq{Id: task.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("restore purged: expected: %v, got: %v", codes.NotFound, err)
+	}
+}
+
+func TestToDoService_Batch(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+
+	created, err := client.BatchCreate(context.Background(), &pb.BatchCreateReq{
+		Tasks: []*pb.Task{
+			{Assignee: "Lola", Title: "Batch one"},
+			{Assignee: "Lola", Title: ""},
+		},
+		BestEffort: true,
+	})
+	if err != nil {
+		t.Fatalf("batch create: %v", err)
+	}
+	if created.Results[0].Code != int32(codes.OK) || created.Results[0].Task.GetStatus() != string(service.StatusTodo) {
+		t.Fatalf("batch create: item 0: got: %v", created.Results[0])
+	}
+	if created.Results[1].Code != int32(codes.InvalidArgument) {
+		t.Fatalf("batch create: item 1: expected: %v, got: %v", codes.InvalidArgument, created.Results[1])
+	}
+
+	_, err = client.BatchCreate(context.Background(), &pb.BatchCreateReq{
+		Tasks: []*pb.Task{{Title: "fine"}, {Title: ""}},
+	})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(status.Convert(err).Message(), "tasks[1].title") {
+		t.Fatalf("batch create all-or-nothing: expected tasks[1].title to be invalid, got: %v", err)
+	}
+
+	id := created.Results[0].Task.Id
+	// ids are accepted in any form a UUID is, as they are by Update
+	updated, err := client.BatchUpdate(context.Background(), &pb.BatchUpdateReq{
+		Tasks: []*pb.Task{{Id: strings.ToUpper(id), Status: "blocked", UpdateMask: &types.FieldMask{Paths: []string{"status"}}}},
+	})
+	if err != nil {
+		t.Fatalf("batch update: %v", err)
+	}
+	if updated.Results[0].Task.GetId() != id || updated.Results[0].Task.GetStatus() != "blocked" {
+		t.Fatalf("batch update: item 0: got: %v", updated.Results[0])
+	}
+	_, err = client.BatchUpdate(context.Background(), &pb.BatchUpdateReq{
+		Tasks: []*pb.Task{{Id: id, Status: "done", UpdateMask: &types.FieldMask{Paths: []string{"status"}}}},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("batch update blocked to done: expected: %v, got: %v", codes.FailedPrecondition, err)
+	}
+
+	deleted, err := client.BatchDelete(context.Background(), &pb.BatchDeleteReq{
+		Tasks:      []*pb.ByIdReq{{Id: id}, {Id: id}},
+		BestEffort: true,
+	})
+	if err != nil {
+		t.Fatalf("batch delete: %v", err)
+	}
+	if deleted.Results[0].Code != int32(codes.OK) || deleted.Results[1].Code != int32(codes.NotFound) {
+		t.Fatalf("batch delete: expected the repeated id to be not found, got: %v", deleted.Results)
+	}
+}
+
+func TestToDoService_Search(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+
+	task, err := client.Create(context.Background(), &pb.Task{Assignee: "Lola", Title: "Find the lighthouse keys"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	got, err := client.Search(context.Background(), &pb.SearchReq{Query: "lighthouse", Assignee: "Lola", Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	found := false
+	for _, r := range got.Results {
+		found = found || r.Task.Id == task.Id
+	}
+	if !found {
+		t.Fatalf("search: expected %s, got: %v", task.Id, got.Results)
+	}
+
+	_, err = client.Search(context.Background(), &pb.SearchReq{Limit: 10})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("empty query: expected: %v, got: %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestToDoService_DeadlineTimeZone(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+
+	got, err := client.Create(context.Background(), &pb.Task{Title: "Call home", Deadline: "2021-12-24 18:00:00", TimeZone: "Asia/Tashkent"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	want := &types.Timestamp{Seconds: time.Date(2021, 12, 24, 13, 0, 0, 0, time.UTC).Unix()}
+	if !got.DeadlineTime.Equal(want) || got.Deadline != "2021-12-24T13:00:00Z" {
+		t.Fatalf("expected: %v, got: %v, %v", want, got.DeadlineTime, got.Deadline)
+	}
+
+	_, err = client.Create(context.Background(), &pb.Task{Title: "Call home", Deadline: "2021-12-24", TimeZone: "Tashkent"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("unknown time zone: expected: %v, got: %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestToDoService_DeadlineExpressions(t *testing.T) {
+	client := newClient(t, "2021-12-22", seedTask)
+
+	before := time.Now()
+	got, err := client.Create(context.Background(), &pb.Task{Title: "Renew visa", Deadline: "+3d"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	after := time.Now()
+	deadline, _ := types.TimestampFromProto(got.DeadlineTime)
+	if deadline.Before(before.AddDate(0, 0, 3).Truncate(time.Second)) || deadline.After(after.AddDate(0, 0, 3)) {
+		t.Fatalf("+3d: expected three days from now, got: %v", deadline)
+	}
+
+	_, err = client.Create(context.Background(), &pb.Task{Title: "Renew visa", Deadline: "01.12.2021"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ambiguous deadline: expected: %v, got: %v", codes.InvalidArgument, err)
+	}
+
+	overdue, err := client.ListOverdue(context.Background(), &pb.ByDeadlineReq{Deadline: "tomorrow", Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("list overdue: %v", err)
+	}
+	if len(overdue.Tasks) != 1 || overdue.Tasks[0].Id != seedTask.task.Id {
+		t.Fatalf("list overdue: expected: %s, got: %v", seedTask.task.Id, overdue.Tasks)
+	}
+
+	_, err = client.List(context.Background(), &pb.ListReq{Page: 1, Limit: 10, CreatedFrom: "someday"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("bad filter: expected: %v, got: %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestToDoService_DefaultTimeZone(t *testing.T) {
+	cfg := config.Load()
+	cfg.DefaultTimeZone = "Asia/Tashkent"
+	client := servicetest.New(t, servicetest.Options{Config: &cfg}).Client
+
+	got, err := client.Create(context.Background(), &pb.Task{Title: "Call home", Deadline: "2021-12-24"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if got.Deadline != "2021-12-23T19:00:00Z" {
+		t.Fatalf("expected: 2021-12-23T19:00:00Z, got: %v", got.Deadline)
+	}
+}
+
+func TestToDoService_Recurrence(t *testing.T) {
+	client := newClient(t, "2021-12-22")
+	ctx := metadata.AppendToOutgoingContext(context.Background(), service.ActorHeader, "lola")
+
+	first, err := client.Create(ctx, &pb.Task{Title: "Water plants", Deadline: "2021-12-06 09:00:00", TimeZone: "Asia/Tashkent", Recurrence: "freq=weekly;count=2"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if first.Recurrence != "FREQ=WEEKLY;COUNT=2" || first.SeriesId != first.Id || first.Occurrence != 1 {
+		t.Fatalf("expected the first occurrence of a weekly series, got: %v", first)
+	}
+
+	series := func() []*pb.Task {
+		t.Helper()
+		resp, err := client.List(ctx, &pb.ListReq{Page: 1, Limit: 10, SeriesId: first.SeriesId, SortBy: "deadline"})
+		if err != nil {
+			t.Fatalf("list series: %v", err)
+		}
+		return resp.Tasks
+	}
+	complete := func(id string) {
+		t.Helper()
+		if _, err := client.ChangeStatus(ctx, &pb.ChangeStatusReq{Id: id, Status: "done"}); err != nil {
+			t.Fatalf("complete %s: %v", id, err)
+		}
+	}
+
+	complete(first.Id)
+	tasks := series()
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 occurrences, got: %v", tasks)
+	}
+	second := tasks[1]
+	if second.Deadline != "2021-12-13T04:00:00Z" || second.Occurrence != 2 || second.Status != "todo" || second.Title != first.Title {
+		t.Fatalf("unexpected second occurrence: %v", second)
+	}
+	history, err := client.GetTaskHistory(ctx, &pb.TaskHistoryReq{TaskId: second.Id})
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if len(history.Changes) != 1 || history.Changes[0].Actor != "lola" {
+		t.Fatalf("expected the next occurrence to be created by lola, got: %v", history.Changes)
+	}
+
+	if _, err := client.ChangeStatus(ctx, &pb.ChangeStatusReq{Id: first.Id, Status: "todo"}); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	complete(first.Id)
+	if tasks := series(); len(tasks) != 2 {
+		t.Fatalf("completing again: expected 2 occurrences, got: %d", len(tasks))
+	}
+
+	complete(second.Id)
+	if tasks := series(); len(tasks) != 2 {
+		t.Fatalf("after COUNT: expected 2 occurrences, got: %d", len(tasks))
+	}
+
+	tests := []struct {
+		name string
+		task *pb.Task
+	}{
+		{name: "no deadline", task: &pb.Task{Title: "Water plants", Recurrence: "FREQ=DAILY"}},
+		{name: "unsupported rule", task: &pb.Task{Title: "Water plants", Deadline: "2021-12-06", Recurrence: "FREQ=YEARLY"}},
+	}
+	for _, tc := range tests {
+		_, err := client.Create(ctx, tc.task)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected: %v, got: %v", tc.name, codes.InvalidArgument, err)
+		}
+	}
+
+	plain, err := client.Create(ctx, &pb.Task{Title: "No deadline"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	_, err = client.Update(ctx, &pb.Task{Id: plain.Id, Recurrence: "FREQ=DAILY", UpdateMask: &types.FieldMask{Paths: []string{"recurrence"}}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("recurrence without deadline: expected: %v, got: %v", codes.InvalidArgument, err)
+	}
+}
@@ -0,0 +1,435 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/deadline"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/recurrence"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// Column sizes of todos, see migrations/000001_create_todos_table.up.sql.
+const (
+	maxAssigneeLen = 50
+	maxTitleLen    = 50
+	maxSummaryLen  = 100
+)
+
+// maxRecurrenceLen is the size of todos.recurrence, see migrations/000007_task_recurrence.up.sql.
+const maxRecurrenceLen = 200
+
+// maxQueryLen caps search queries; a longer one can't match a title and summary of the sizes above.
+const maxQueryLen = 200
+
+// Column sizes of webhooks, see migrations/000010_webhooks.up.sql. Shorter secrets are
+// too easy to guess.
+const (
+	maxWebhookURLLen    = 2048
+	maxWebhookSecretLen = 128
+	minWebhookSecretLen = 16
+)
+
+// maxWorkspaceNameLen is the size of workspaces.name, see migrations/000015_workspaces.up.sql.
+const maxWorkspaceNameLen = 100
+
+// Column sizes of projects, see migrations/000016_projects.up.sql.
+const (
+	maxProjectNameLen        = 100
+	maxProjectDescriptionLen = 500
+)
+
+// defaultPageSize is the page size of webhook, workspace and project listings, subtask lists
+// and task histories that don't set a limit.
+const defaultPageSize = 50
+
+// taskParser is the deadline parser for a task: p, moved to the task's time zone when it has one.
+func taskParser(p deadline.Parser, task *pb.Task) (deadline.Parser, error) {
+	if task.TimeZone == "" {
+		return p, nil
+	}
+	return p.In(task.TimeZone)
+}
+
+// normalizeDeadline resolves a validated task's deadline to an instant and sets both of
+// its forms, Deadline in RFC3339 and UTC.
+func normalizeDeadline(p deadline.Parser, task *pb.Task) {
+	var t time.Time
+	switch {
+	case task.DeadlineTime != nil:
+		t, _ = types.TimestampFromProto(task.DeadlineTime)
+	case task.Deadline != "":
+		p, _ = taskParser(p, task)
+		t, _ = p.Parse(task.Deadline)
+	default:
+		return
+	}
+
+	t = t.UTC()
+	task.Deadline = t.Format(time.RFC3339)
+	task.DeadlineTime, _ = types.TimestampProto(t)
+}
+
+// validator collects field violations so a request can be rejected with all of them at once.
+type validator struct {
+	deadlines  deadline.Parser
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (v *validator) addf(field, format string, args ...interface{}) {
+	v.violations = append(v.violations, fieldViolation(field, fmt.Sprintf(format, args...)))
+}
+
+func (v *validator) id(field, id string) {
+	if id == "" {
+		v.addf(field, "is required")
+		return
+	}
+	if _, err := uuid.FromString(id); err != nil {
+		v.addf(field, "must be a UUID")
+	}
+}
+
+// paging checks the page and limit of a listing. Task listings read a page of 0 as a
+// request for token paging, so the defaults are left to the callers.
+func (v *validator) paging(page, limit int64) {
+	if page < 0 {
+		v.addf("page", "must not be negative")
+	}
+	if limit < 0 {
+		v.addf("limit", "must not be negative")
+	}
+}
+
+func (v *validator) maxLen(field, value string, max int) {
+	if n := utf8.RuneCountInString(value); n > max {
+		v.addf(field, "must be at most %d characters, got %d", max, n)
+	}
+}
+
+func (v *validator) deadline(field, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := v.deadlines.Parse(value); err != nil {
+		v.addf(field, err.Error())
+	}
+}
+
+// resolve parses the date filter *value and replaces it with the RFC3339 UTC instant it
+// stands for, the only form storage has to understand.
+func (v *validator) resolve(field string, value *string) {
+	if *value == "" {
+		return
+	}
+	t, err := v.deadlines.Parse(*value)
+	if err != nil {
+		v.addf(field, err.Error())
+		return
+	}
+	*value = t.UTC().Format(time.RFC3339Nano)
+}
+
+// taskDeadline checks the deadline of task in whichever form it was given.
+func (v *validator) taskDeadline(task *pb.Task) {
+	p, err := taskParser(v.deadlines, task)
+	if err != nil {
+		v.addf("time_zone", err.Error())
+		p = v.deadlines
+	}
+	if task.DeadlineTime != nil {
+		if _, err := types.TimestampFromProto(task.DeadlineTime); err != nil {
+			v.addf("deadline_time", err.Error())
+		}
+		return
+	}
+	if task.Deadline == "" {
+		return
+	}
+	if _, err := p.Parse(task.Deadline); err != nil {
+		v.addf("deadline", err.Error())
+	}
+}
+
+func (v *validator) status(field, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := ParseTaskStatus(value); err != nil {
+		v.addf(field, err.Error())
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return invalidArgument(v.violations...)
+}
+
+// validateTask checks the task fields that will be written, all of them when fields is nil.
+// An empty status is allowed: Create defaults it and Update keeps the current one.
+func validateTask(p deadline.Parser, task *pb.Task, fields []string) []*errdetails.BadRequest_FieldViolation {
+	writes := func(field string) bool {
+		return fields == nil || hasField(fields, field)
+	}
+
+	v := validator{deadlines: p}
+	if writes("title") {
+		if strings.TrimSpace(task.Title) == "" {
+			v.addf("title", "is required")
+		}
+		v.maxLen("title", task.Title, maxTitleLen)
+	}
+	if writes("assignee") {
+		v.maxLen("assignee", task.Assignee, maxAssigneeLen)
+	}
+	if writes("summary") {
+		v.maxLen("summary", task.Summary, maxSummaryLen)
+	}
+	if writes("deadline") {
+		v.taskDeadline(task)
+	}
+	if writes("status") {
+		v.status("status", task.Status)
+	}
+	if writes("project_id") && task.ProjectId != "" {
+		v.id("project_id", task.ProjectId)
+	}
+	if writes("parent_id") && task.ParentId != "" {
+		v.id("parent_id", task.ParentId)
+	}
+	if writes("recurrence") && task.Recurrence != "" {
+		v.maxLen("recurrence", task.Recurrence, maxRecurrenceLen)
+		if _, err := recurrence.Parse(task.Recurrence); err != nil {
+			v.addf("recurrence", err.Error())
+		} else if writes("deadline") && task.DeadlineTime == nil && task.Deadline == "" {
+			v.addf("recurrence", "needs a deadline to recur from")
+		}
+	}
+
+	return v.violations
+}
+
+func validateCreate(p deadline.Parser, task *pb.Task) error {
+	v := validator{violations: validateTask(p, task, nil)}
+	return v.err()
+}
+
+func validateUpdate(p deadline.Parser, task *pb.Task, fields []string) error {
+	var v validator
+	v.id("id", task.Id)
+	v.violations = append(v.violations, validateTask(p, task, fields)...)
+	return v.err()
+}
+
+func validateChangeStatus(req *pb.ChangeStatusReq) error {
+	var v validator
+	v.id("id", req.Id)
+	if req.Status == "" {
+		v.addf("status", "is required")
+	}
+	v.status("status", req.Status)
+	return v.err()
+}
+
+func validateID(id string) error {
+	var v validator
+	v.id("id", id)
+	return v.err()
+}
+
+func validatePurge(p deadline.Parser, req *pb.PurgeReq) error {
+	v := validator{deadlines: p}
+	switch {
+	case req.Id == "" && req.DeletedBefore == "":
+		v.addf("id", "either id or deleted_before is required")
+	case req.Id != "" && req.DeletedBefore != "":
+		v.addf("deleted_before", "can't be combined with id")
+	case req.Id != "":
+		v.id("id", req.Id)
+	default:
+		v.deadline("deleted_before", req.DeletedBefore)
+	}
+	return v.err()
+}
+
+// resolveListDates validates the paging and date filters of req and rewrites the dates as
+// RFC3339 UTC instants.
+func resolveListDates(p deadline.Parser, req *pb.ListReq) error {
+	v := validator{deadlines: p}
+	if req.SeriesId != "" {
+		v.id("series_id", req.SeriesId)
+	}
+	if req.ProjectId != "" {
+		v.id("project_id", req.ProjectId)
+	}
+	v.paging(req.Page, req.Limit)
+	v.resolve("deadline_from", &req.DeadlineFrom)
+	v.resolve("deadline_to", &req.DeadlineTo)
+	v.resolve("created_from", &req.CreatedFrom)
+	v.resolve("created_to", &req.CreatedTo)
+	return v.err()
+}
+
+// resolveOverdue validates the paging and cutoff of req and rewrites the cutoff as an
+// RFC3339 UTC instant.
+func resolveOverdue(p deadline.Parser, req *pb.ByDeadlineReq) error {
+	v := validator{deadlines: p}
+	if req.Deadline == "" {
+		v.addf("deadline", "is required")
+	}
+	v.resolve("deadline", &req.Deadline)
+	v.paging(req.Page, req.Limit)
+	return v.err()
+}
+
+func validateSearch(req *pb.SearchReq) error {
+	var v validator
+	if strings.TrimSpace(req.Query) == "" {
+		v.addf("query", "is required")
+	}
+	v.maxLen("query", req.Query, maxQueryLen)
+	v.status("status", req.Status)
+	v.paging(req.Page, req.Limit)
+	return v.err()
+}
+
+func validateWatch(req *pb.WatchTasksReq) error {
+	var v validator
+	v.maxLen("assignee", req.Assignee, maxAssigneeLen)
+	v.status("status", req.Status)
+	if req.FromRevision < 0 {
+		v.addf("from_revision", "must not be negative")
+	}
+	return v.err()
+}
+
+// validateWebhook checks a webhook to create, or to update when update is set and the
+// id is required too. An empty secret is allowed: Create generates one and Update keeps it.
+func validateWebhook(hook *pb.Webhook, update bool) error {
+	var v validator
+	if update {
+		v.id("id", hook.Id)
+	}
+
+	if hook.Url == "" {
+		v.addf("url", "is required")
+	} else if u, err := url.Parse(hook.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.addf("url", "must be an absolute http or https URL")
+	}
+	v.maxLen("url", hook.Url, maxWebhookURLLen)
+
+	if len(hook.Events) == 0 {
+		v.addf("events", "at least one is required")
+	}
+	seen := map[string]bool{}
+	for i, event := range hook.Events {
+		field := fmt.Sprintf("events[%d]", i)
+		switch {
+		case !repo.EventType(event).Valid():
+			v.addf(field, "must be one of %s", eventNames())
+		case seen[event]:
+			v.addf(field, "%s is listed twice", event)
+		}
+		seen[event] = true
+	}
+
+	if hook.Secret != "" && utf8.RuneCountInString(hook.Secret) < minWebhookSecretLen {
+		v.addf("secret", "must be at least %d characters", minWebhookSecretLen)
+	}
+	v.maxLen("secret", hook.Secret, maxWebhookSecretLen)
+
+	return v.err()
+}
+
+func eventNames() string {
+	names := make([]string, len(repo.EventTypes))
+	for i, t := range repo.EventTypes {
+		names[i] = string(t)
+	}
+	return strings.Join(names, ", ")
+}
+
+func validateWorkspace(workspace *pb.Workspace, update bool) error {
+	var v validator
+	if update {
+		v.id("id", workspace.Id)
+	}
+	if strings.TrimSpace(workspace.Name) == "" {
+		v.addf("name", "is required")
+	}
+	v.maxLen("name", workspace.Name, maxWorkspaceNameLen)
+
+	return v.err()
+}
+
+func validateProject(project *pb.Project, update bool) error {
+	var v validator
+	if update {
+		v.id("id", project.Id)
+	}
+	if strings.TrimSpace(project.Name) == "" {
+		v.addf("name", "is required")
+	}
+	v.maxLen("name", project.Name, maxProjectNameLen)
+	v.maxLen("description", project.Description, maxProjectDescriptionLen)
+	if project.Color != "" && !isHexColor(project.Color) {
+		v.addf("color", "must be a #RRGGBB hex color")
+	}
+
+	return v.err()
+}
+
+// isHexColor tells whether value is a color in #RRGGBB form.
+func isHexColor(value string) bool {
+	if len(value) != 7 || value[0] != '#' {
+		return false
+	}
+	for _, c := range value[1:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPage validates the paging of a webhook, workspace or project listing, a subtask list
+// or a task history and fills in the defaults.
+func checkPage(page, limit *int64) error {
+	var v validator
+	v.paging(*page, *limit)
+	if *page == 0 {
+		*page = 1
+	}
+	if *limit == 0 {
+		*limit = defaultPageSize
+	}
+	return v.err()
+}
+
+func validateTaskHistory(req *pb.TaskHistoryReq) error {
+	var v validator
+	v.id("task_id", req.TaskId)
+	if err := v.err(); err != nil {
+		return err
+	}
+	return checkPage(&req.Page, &req.Limit)
+}
+
+func validateListSubtasks(req *pb.ListSubtasksReq) error {
+	var v validator
+	v.id("parent_id", req.ParentId)
+	if err := v.err(); err != nil {
+		return err
+	}
+	return checkPage(&req.Page, &req.Limit)
+}
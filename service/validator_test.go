@@ -0,0 +1,295 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/deadline"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// testDeadlines reads deadlines in UTC on 2021-12-22, a Wednesday.
+var testDeadlines = deadline.Parser{
+	Location: time.UTC,
+	Now:      func() time.Time { return time.Date(2021, 12, 22, 10, 0, 0, 0, time.UTC) },
+}
+
+func TestValidateTask(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  pb.Task
+		fields []string
+		want   []string
+	}{
+		{
+			name:  "valid",
+			input: pb.Task{Title: "Test", Assignee: "Lola", Deadline: "2021-12-01", Status: "todo"},
+			want:  nil,
+		},
+		{
+			name:  "month first dotted deadline",
+			input: pb.Task{Title: "Test", Deadline: "12.25.2021"},
+			want:  nil,
+		},
+		{
+			name:  "ambiguous dotted deadline",
+			input: pb.Task{Title: "Test", Deadline: "01.12.2021"},
+			want:  []string{"deadline"},
+		},
+		{
+			name:  "relative deadline",
+			input: pb.Task{Title: "Test", Deadline: "next friday"},
+			want:  nil,
+		},
+		{
+			name: "every field wrong at once",
+			input: pb.Task{
+				Title:    " ",
+				Assignee: strings.Repeat("a", 51),
+				Summary:  strings.Repeat("s", 101),
+				Deadline: "someday",
+				Status:   "Passed",
+			},
+			want: []string{"title", "assignee", "summary", "deadline", "status"},
+		},
+		{
+			name:  "title over column size",
+			input: pb.Task{Title: strings.Repeat("t", 51)},
+			want:  []string{"title"},
+		},
+		{
+			name:  "length counts characters, not bytes",
+			input: pb.Task{Title: strings.Repeat("ё", 50)},
+			want:  nil,
+		},
+		{
+			name:   "only masked fields are checked",
+			input:  pb.Task{Deadline: "someday"},
+			fields: []string{"summary"},
+			want:   nil,
+		},
+		{
+			name:  "deadline in a named time zone",
+			input: pb.Task{Title: "Test", Deadline: "2021-12-01", TimeZone: "Asia/Tashkent"},
+			want:  nil,
+		},
+		{
+			name:  "unknown time zone",
+			input: pb.Task{Title: "Test", Deadline: "2021-12-01", TimeZone: "Mars/Olympus"},
+			want:  []string{"time_zone"},
+		},
+		{
+			name:  "deadline_time out of range",
+			input: pb.Task{Title: "Test", DeadlineTime: &types.Timestamp{Seconds: -62135596801}},
+			want:  []string{"deadline_time"},
+		},
+		{
+			name:  "recurring task",
+			input: pb.Task{Title: "Test", Deadline: "2021-12-01", Recurrence: "FREQ=WEEKLY;BYDAY=MO"},
+			want:  nil,
+		},
+		{
+			name:  "recurrence without deadline",
+			input: pb.Task{Title: "Test", Recurrence: "FREQ=WEEKLY"},
+			want:  []string{"recurrence"},
+		},
+		{
+			name:   "masked recurrence keeps the stored deadline",
+			input:  pb.Task{Recurrence: "FREQ=WEEKLY"},
+			fields: []string{"recurrence"},
+			want:   nil,
+		},
+		{
+			name:  "unsupported recurrence",
+			input: pb.Task{Title: "Test", Deadline: "2021-12-01", Recurrence: "FREQ=HOURLY"},
+			want:  []string{"recurrence"},
+		},
+		{
+			name:   "masked title is still required",
+			input:  pb.Task{},
+			fields: []string{"title"},
+			want:   []string{"title"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, v := range validateTask(testDeadlines, &tc.input, tc.fields) {
+				got = append(got, v.Field)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   pb.Task
+		wantErr bool
+	}{
+		{name: "valid", input: pb.Task{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Title: "Test"}},
+		{name: "missing id", input: pb.Task{Title: "Test"}, wantErr: true},
+		{name: "malformed id", input: pb.Task{Id: "42", Title: "Test"}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateUpdate(testDeadlines, &tc.input, nil)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidatePurge(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   pb.PurgeReq
+		wantErr bool
+	}{
+		{name: "by id", input: pb.PurgeReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9"}},
+		{name: "by cutoff", input: pb.PurgeReq{DeletedBefore: "2021-12-01"}},
+		{name: "nothing selected", input: pb.PurgeReq{}, wantErr: true},
+		{name: "both selected", input: pb.PurgeReq{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", DeletedBefore: "2021-12-01"}, wantErr: true},
+		{name: "relative cutoff", input: pb.PurgeReq{DeletedBefore: "-30d"}},
+		{name: "bad cutoff", input: pb.PurgeReq{DeletedBefore: "last week"}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePurge(testDeadlines, &tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateSearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   pb.SearchReq
+		wantErr bool
+	}{
+		{name: "valid", input: pb.SearchReq{Query: "release notes", Status: "todo"}},
+		{name: "empty query", input: pb.SearchReq{Query: "  "}, wantErr: true},
+		{name: "long query", input: pb.SearchReq{Query: strings.Repeat("a", maxQueryLen+1)}, wantErr: true},
+		{name: "unknown status", input: pb.SearchReq{Query: "notes", Status: "someday"}, wantErr: true},
+		{name: "negative limit", input: pb.SearchReq{Query: "notes", Limit: -1}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSearch(&tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateWebhook(t *testing.T) {
+	const id = "24465fe0-9ea1-45ce-8a7a-79c63972efe9"
+	tests := []struct {
+		name    string
+		input   pb.Webhook
+		update  bool
+		wantErr bool
+	}{
+		{name: "valid", input: pb.Webhook{Url: "https://example.com/hooks", Events: []string{"task.created", "task.completed"}}},
+		{name: "own secret", input: pb.Webhook{Url: "http://localhost:8080", Events: []string{"task.deleted"}, Secret: strings.Repeat("s", minWebhookSecretLen)}},
+		{name: "short secret", input: pb.Webhook{Url: "https://example.com", Events: []string{"task.deleted"}, Secret: "s3cret"}, wantErr: true},
+		{name: "long secret", input: pb.Webhook{Url: "https://example.com", Events: []string{"task.deleted"}, Secret: strings.Repeat("s", maxWebhookSecretLen+1)}, wantErr: true},
+		{name: "no url", input: pb.Webhook{Events: []string{"task.created"}}, wantErr: true},
+		{name: "relative url", input: pb.Webhook{Url: "/hooks", Events: []string{"task.created"}}, wantErr: true},
+		{name: "other scheme", input: pb.Webhook{Url: "ftp://example.com", Events: []string{"task.created"}}, wantErr: true},
+		{name: "long url", input: pb.Webhook{Url: "https://example.com/" + strings.Repeat("a", maxWebhookURLLen), Events: []string{"task.created"}}, wantErr: true},
+		{name: "no events", input: pb.Webhook{Url: "https://example.com"}, wantErr: true},
+		{name: "unknown event", input: pb.Webhook{Url: "https://example.com", Events: []string{"task.archived"}}, wantErr: true},
+		{name: "event twice", input: pb.Webhook{Url: "https://example.com", Events: []string{"task.created", "task.created"}}, wantErr: true},
+		{name: "update", input: pb.Webhook{Id: id, Url: "https://example.com", Events: []string{"task.updated"}}, update: true},
+		{name: "update without id", input: pb.Webhook{Url: "https://example.com", Events: []string{"task.updated"}}, update: true, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateWebhook(&tc.input, tc.update)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeDeadline(t *testing.T) {
+	tests := []struct {
+		name  string
+		input pb.Task
+		want  string
+	}{
+		{name: "date in UTC", input: pb.Task{Deadline: "2021-12-01"}, want: "2021-12-01T00:00:00Z"},
+		{name: "date in a time zone", input: pb.Task{Deadline: "2021-12-01", TimeZone: "Asia/Tashkent"}, want: "2021-11-30T19:00:00Z"},
+		{name: "offset wins over time zone", input: pb.Task{Deadline: "2021-12-01T10:00:00+01:00", TimeZone: "Asia/Tashkent"}, want: "2021-12-01T09:00:00Z"},
+		{name: "relative date", input: pb.Task{Deadline: "tomorrow"}, want: "2021-12-23T00:00:00Z"},
+		{name: "relative date in a time zone", input: pb.Task{Deadline: "tomorrow", TimeZone: "Asia/Tashkent"}, want: "2021-12-22T19:00:00Z"},
+		{name: "deadline_time wins over deadline", input: pb.Task{Deadline: "2030-01-01", DeadlineTime: &types.Timestamp{Seconds: 1638316800}}, want: "2021-12-01T00:00:00Z"},
+		{name: "no deadline", input: pb.Task{}, want: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			normalizeDeadline(testDeadlines, &tc.input)
+			if tc.input.Deadline != tc.want {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, tc.input.Deadline)
+			}
+			if tc.want != "" && tc.input.DeadlineTime == nil {
+				t.Fatalf("%s: expected deadline_time to be set", tc.name)
+			}
+		})
+	}
+}
+
+func TestResolveListDates(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   pb.ListReq
+		want    pb.ListReq
+		wantErr bool
+	}{
+		{name: "no filters", input: pb.ListReq{Page: 1}, want: pb.ListReq{Page: 1}},
+		{
+			name:  "dates and expressions",
+			input: pb.ListReq{DeadlineFrom: "2021-12-01", DeadlineTo: "+1w", CreatedFrom: "-3d", CreatedTo: "2021-12-22T15:00:00+05:00"},
+			want: pb.ListReq{
+				DeadlineFrom: "2021-12-01T00:00:00Z",
+				DeadlineTo:   "2021-12-29T10:00:00Z",
+				CreatedFrom:  "2021-12-19T10:00:00Z",
+				CreatedTo:    "2021-12-22T10:00:00Z",
+			},
+		},
+		{name: "ambiguous date", input: pb.ListReq{CreatedTo: "02/03/2021"}, wantErr: true},
+		{name: "negative page", input: pb.ListReq{Page: -1}, wantErr: true},
+		{name: "negative limit", input: pb.ListReq{Limit: -10}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resolveListDates(testDeadlines, &tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%s: expected error: %v, got: %v", tc.name, tc.wantErr, err)
+			}
+			if !tc.wantErr && !reflect.DeepEqual(tc.want, tc.input) {
+				t.Fatalf("%s: expected: %+v, got: %+v", tc.name, tc.want, tc.input)
+			}
+		})
+	}
+}
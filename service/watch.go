@@ -0,0 +1,64 @@
+package service
+
+import (
+	"errors"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+	"github.com/NafisaTojiboyeva/todo-service/watch"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ToDoService) WatchTasks(req *pb.WatchTasksReq, stream pb.ToDoService_WatchTasksServer) error {
+	if err := validateWatch(req); err != nil {
+		return err
+	}
+	if s.watcher == nil {
+		return status.Error(codes.Unimplemented, "watching tasks is not enabled")
+	}
+
+	taskStatus := ""
+	if req.Status != "" {
+		parsed, _ := ParseTaskStatus(req.Status)
+		taskStatus = string(parsed)
+	}
+	ctx := stream.Context()
+	workspace, err := s.workspace(ctx)
+	if err != nil {
+		return err
+	}
+	access := accessOf(ctx)
+	match := func(e repo.Event) bool {
+		return e.Task.WorkspaceId == workspace && access.sees(e.Task) &&
+			(req.Assignee == "" || e.Task.Assignee == req.Assignee) &&
+			(taskStatus == "" || e.Task.Status == taskStatus)
+	}
+
+	err = s.watcher.Watch(ctx, req.FromRevision, match, func(e repo.Event) error {
+		return stream.Send(taskEvent(e))
+	})
+	switch {
+	case errors.Is(err, watch.ErrFutureRevision):
+		return invalidArgument(fieldViolation("from_revision", err.Error()))
+	case errors.Is(err, watch.ErrStopped):
+		return status.Error(codes.Unavailable, "server is shutting down, watch again")
+	case ctx.Err() != nil:
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return s.toStatus(err, "failed to watch tasks")
+}
+
+func taskEvent(e repo.Event) *pb.TaskEvent {
+	task := e.Task
+	occurred, _ := types.TimestampProto(e.OccurredAt)
+	return &pb.TaskEvent{
+		Revision:     e.Revision,
+		Id:           e.ID,
+		Type:         string(e.Type),
+		Task:         &task,
+		OccurredTime: occurred,
+	}
+}
@@ -0,0 +1,98 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/outbox"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToDoService_WatchTasks(t *testing.T) {
+	srv := servicetest.New(t, servicetest.Options{})
+	client := srv.Client
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	relay := outbox.NewRelay(srv.Storage.Outbox(), outbox.NewMemoryPublisher(), l.New("error", "test"), outbox.Options{Relayed: srv.Hub.Wake})
+	flush := func() {
+		t.Helper()
+		if _, err := relay.Flush(ctx); err != nil {
+			t.Fatalf("relay: %v", err)
+		}
+	}
+
+	report, err := client.Create(ctx, &pb.Task{Assignee: "lola", Title: "Write report"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	flush()
+
+	// Resuming after the first event makes the streams independent of when they start.
+	everything, err := client.WatchTasks(ctx, &pb.WatchTasksReq{FromRevision: 1})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	finished, err := client.WatchTasks(ctx, &pb.WatchTasksReq{Assignee: "lola", Status: "DONE", FromRevision: 1})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+
+	if _, err := client.ChangeStatus(ctx, &pb.ChangeStatusReq{Id: report.Id, Status: "done"}); err != nil {
+		t.Fatalf("change status: %v", err)
+	}
+	if _, err := client.Create(ctx, &pb.Task{Assignee: "bob", Title: "Plan trip", Status: "done"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	flush()
+
+	recv := func(stream pb.ToDoService_WatchTasksClient, n int) []string {
+		t.Helper()
+		got := []string{}
+		for i := 0; i < n; i++ {
+			e, err := stream.Recv()
+			if err != nil {
+				t.Fatalf("recv: %v", err)
+			}
+			if e.Id == "" || e.Task == nil || e.OccurredTime == nil {
+				t.Fatalf("expected an event with an id, a task and a time, got: %v", e)
+			}
+			got = append(got, fmt.Sprintf("%d %s %s", e.Revision, e.Type, e.Task.Title))
+		}
+		return got
+	}
+
+	want := []string{"2 task.updated Write report", "3 task.completed Write report", "4 task.created Plan trip"}
+	if got := recv(everything, 3); !reflect.DeepEqual(got, want) {
+		t.Errorf("all events: expected %v, got %v", want, got)
+	}
+	want = []string{"2 task.updated Write report", "3 task.completed Write report"}
+	if got := recv(finished, 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("done tasks of lola: expected %v, got %v", want, got)
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.WatchTasksReq
+	}{
+		{name: "unknown status", req: &pb.WatchTasksReq{Status: "later"}},
+		{name: "negative revision", req: &pb.WatchTasksReq{FromRevision: -1}},
+		{name: "future revision", req: &pb.WatchTasksReq{FromRevision: 5}},
+	}
+	for _, tc := range tests {
+		stream, err := client.WatchTasks(ctx, tc.req)
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected: %v, got: %v", tc.name, codes.InvalidArgument, err)
+		}
+	}
+}
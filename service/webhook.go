@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ToDoService) CreateWebhook(ctx context.Context, req *pb.Webhook) (*pb.Webhook, error) {
+	if err := requireAdmin(ctx, "webhooks"); err != nil {
+		return nil, err
+	}
+	if err := validateWebhook(req, false); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		s.logger.Error("failed while generating uuid", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed generate uuid")
+	}
+	req.Id = id.String()
+	if req.Secret == "" {
+		if req.Secret, err = newSecret(); err != nil {
+			s.logger.Error("failed while generating webhook secret", l.Error(err))
+			return nil, status.Error(codes.Internal, "failed to generate secret")
+		}
+	}
+
+	hook, err := s.storage.Webhook().Create(*req)
+	if err != nil {
+		return nil, s.webhookStatus(err, "failed to create webhook")
+	}
+
+	return &hook, nil
+}
+
+func (s *ToDoService) GetWebhook(ctx context.Context, req *pb.ByIdReq) (*pb.Webhook, error) {
+	if err := requireAdmin(ctx, "webhooks"); err != nil {
+		return nil, err
+	}
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+
+	hook, err := s.storage.Webhook().Get(req.Id)
+	if err != nil {
+		return nil, s.webhookStatus(err, "failed to get webhook")
+	}
+
+	hook.Secret = ""
+	return &hook, nil
+}
+
+func (s *ToDoService) ListWebhooks(ctx context.Context, req *pb.ListWebhooksReq) (*pb.ListWebhooksResp, error) {
+	if err := requireAdmin(ctx, "webhooks"); err != nil {
+		return nil, err
+	}
+	if err := checkPage(&req.Page, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	hooks, err := s.storage.Webhook().List(*req)
+	if err != nil {
+		return nil, s.webhookStatus(err, "failed to list webhooks")
+	}
+
+	for _, hook := range hooks.Webhooks {
+		hook.Secret = ""
+	}
+	return &hooks, nil
+}
+
+func (s *ToDoService) UpdateWebhook(ctx context.Context, req *pb.Webhook) (*pb.Webhook, error) {
+	if err := requireAdmin(ctx, "webhooks"); err != nil {
+		return nil, err
+	}
+	if err := validateWebhook(req, true); err != nil {
+		return nil, err
+	}
+
+	hook, err := s.storage.Webhook().Update(*req)
+	if err != nil {
+		return nil, s.webhookStatus(err, "failed to update webhook")
+	}
+
+	hook.Secret = ""
+	return &hook, nil
+}
+
+func (s *ToDoService) DeleteWebhook(ctx context.Context, req *pb.ByIdReq) (*pb.EmptyResp, error) {
+	if err := requireAdmin(ctx, "webhooks"); err != nil {
+		return nil, err
+	}
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Webhook().Delete(req.Id); err != nil {
+		return nil, s.webhookStatus(err, "failed to delete webhook")
+	}
+
+	return &pb.EmptyResp{}, nil
+}
+
+func (s *ToDoService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesReq) (*pb.ListWebhookDeliveriesResp, error) {
+	if err := requireAdmin(ctx, "webhooks"); err != nil {
+		return nil, err
+	}
+	var v validator
+	v.id("webhook_id", req.WebhookId)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	if err := checkPage(&req.Page, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.storage.Webhook().Get(req.WebhookId); err != nil {
+		return nil, s.webhookStatus(err, "failed to list webhook deliveries")
+	}
+	deliveries, err := s.storage.Webhook().ListDeliveries(*req)
+	if err != nil {
+		return nil, s.webhookStatus(err, "failed to list webhook deliveries")
+	}
+
+	return &deliveries, nil
+}
+
+// webhookStatus is toStatus for webhook calls, where what can't be found is a webhook.
+func (s *ToDoService) webhookStatus(err error, msg string) error {
+	if errors.Is(err, repo.ErrNotFound) {
+		return status.Error(codes.NotFound, "webhook not found")
+	}
+	return s.toStatus(err, msg)
+}
+
+// newSecret returns a random webhook secret of 32 bytes, hex encoded.
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,221 @@
+package service_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/outbox"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// taken relays the pending task events and returns them as "type title" strings.
+func taken(t *testing.T, relay *outbox.Relay, events *outbox.MemoryPublisher) []string {
+	t.Helper()
+
+	before := len(events.Events())
+	if _, err := relay.Flush(context.Background()); err != nil {
+		t.Fatalf("relay: %v", err)
+	}
+	got := []string{}
+	for _, e := range events.Events()[before:] {
+		got = append(got, string(e.Type)+" "+e.Task.Title)
+	}
+	return got
+}
+
+func TestToDoService_Webhooks(t *testing.T) {
+	srv := servicetest.New(t, servicetest.Options{})
+	client := srv.Client
+	ctx := context.Background()
+
+	created, err := client.CreateWebhook(ctx, &pb.Webhook{Url: "https://example.com/hooks", Events: []string{"task.created", "task.completed"}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.Id == "" || len(created.Secret) != 64 || created.CreatedTime == nil {
+		t.Fatalf("expected an id, a generated secret and a creation time, got: %v", created)
+	}
+
+	got, err := client.GetWebhook(ctx, &pb.ByIdReq{Id: created.Id})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Secret != "" || got.Url != created.Url || !reflect.DeepEqual(got.Events, created.Events) {
+		t.Fatalf("expected the webhook without its secret, got: %v", got)
+	}
+
+	updated, err := client.UpdateWebhook(ctx, &pb.Webhook{Id: created.Id, Url: "https://example.com/v2", Events: []string{"task.deleted"}, Disabled: true})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Url != "https://example.com/v2" || !updated.Disabled || updated.Secret != "" {
+		t.Fatalf("unexpected updated webhook: %v", updated)
+	}
+	stored, err := srv.Storage.Webhook().Get(created.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Secret != created.Secret {
+		t.Fatalf("update without a secret changed it")
+	}
+
+	list, err := client.ListWebhooks(ctx, &pb.ListWebhooksReq{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if list.Count != 1 || len(list.Webhooks) != 1 || list.Webhooks[0].Secret != "" {
+		t.Fatalf("expected the one webhook without its secret, got: %v", list)
+	}
+
+	eventID := uuid.Must(uuid.NewV4()).String()
+	for attempt := int32(1); attempt <= 2; attempt++ {
+		err := srv.Storage.Webhook().RecordDelivery(pb.WebhookDelivery{
+			Id: uuid.Must(uuid.NewV4()).String(), WebhookId: created.Id, EventId: eventID,
+			Event: "task.deleted", Attempt: attempt, StatusCode: 200, Success: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	deliveries, err := client.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesReq{WebhookId: created.Id, Limit: 1})
+	if err != nil {
+		t.Fatalf("list deliveries: %v", err)
+	}
+	if deliveries.Count != 2 || len(deliveries.Deliveries) != 1 || deliveries.Deliveries[0].Attempt != 2 {
+		t.Fatalf("expected the latest of 2 deliveries, got: %v", deliveries)
+	}
+
+	if _, err := client.DeleteWebhook(ctx, &pb.ByIdReq{Id: created.Id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	missing := uuid.Must(uuid.NewV4()).String()
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{name: "get deleted", want: codes.NotFound, call: func() error {
+			_, err := client.GetWebhook(ctx, &pb.ByIdReq{Id: created.Id})
+			return err
+		}},
+		{name: "delete deleted", want: codes.NotFound, call: func() error {
+			_, err := client.DeleteWebhook(ctx, &pb.ByIdReq{Id: created.Id})
+			return err
+		}},
+		{name: "update missing", want: codes.NotFound, call: func() error {
+			_, err := client.UpdateWebhook(ctx, &pb.Webhook{Id: missing, Url: "https://example.com", Events: []string{"task.created"}})
+			return err
+		}},
+		{name: "deliveries of missing", want: codes.NotFound, call: func() error {
+			_, err := client.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesReq{WebhookId: missing})
+			return err
+		}},
+		{name: "create invalid", want: codes.InvalidArgument, call: func() error {
+			_, err := client.CreateWebhook(ctx, &pb.Webhook{Url: "example.com", Events: []string{"task.created"}})
+			return err
+		}},
+		{name: "get invalid id", want: codes.InvalidArgument, call: func() error {
+			_, err := client.GetWebhook(ctx, &pb.ByIdReq{Id: "not-a-uuid"})
+			return err
+		}},
+	}
+	for _, tc := range tests {
+		if err := tc.call(); status.Code(err) != tc.want {
+			t.Errorf("%s: expected: %v, got: %v", tc.name, tc.want, err)
+		}
+	}
+}
+
+func TestToDoService_Events(t *testing.T) {
+	srv := servicetest.New(t, servicetest.Options{})
+	client := srv.Client
+	ctx := context.Background()
+	events := outbox.NewMemoryPublisher()
+	relay := outbox.NewRelay(srv.Storage.Outbox(), events, l.New("error", "test"), outbox.Options{BatchSize: 1000})
+
+	task, err := client.Create(ctx, &pb.Task{Title: "Write report"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := client.Update(ctx, &pb.Task{Id: task.Id, Title: "Write the report", Status: "in_progress"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if _, err := client.ChangeStatus(ctx, &pb.ChangeStatusReq{Id: task.Id, Status: "done"}); err != nil {
+		t.Fatalf("change status: %v", err)
+	}
+	if _, err := client.Delete(ctx, &pb.ByIdReq{Id: task.Id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := client.Restore(ctx, &pb.ByIdReq{Id: task.Id}); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if _, err := client.Delete(ctx, &pb.ByIdReq{Id: task.Id}); status.Code(err) != codes.OK {
+		t.Fatalf("delete again: %v", err)
+	}
+	if _, err := client.Delete(ctx, &pb.ByIdReq{Id: task.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("delete deleted: expected: %v, got: %v", codes.NotFound, err)
+	}
+
+	want := []string{
+		"task.created Write report",
+		"task.updated Write the report",
+		"task.updated Write the report",
+		"task.completed Write the report",
+		"task.deleted Write the report",
+		"task.updated Write the report",
+		"task.deleted Write the report",
+	}
+	if got := taken(t, relay, events); !reflect.DeepEqual(got, want) {
+		t.Fatalf("single calls: expected events %v, got %v", want, got)
+	}
+
+	batch, err := client.BatchCreate(ctx, &pb.BatchCreateReq{Tasks: []*pb.Task{{Title: "One"}, {Title: "Two"}}})
+	if err != nil {
+		t.Fatalf("batch create: %v", err)
+	}
+	one, two := batch.Results[0].Task, batch.Results[1].Task
+	_, err = client.BatchUpdate(ctx, &pb.BatchUpdateReq{Tasks: []*pb.Task{{Id: one.Id, Title: "One", Status: "done"}}})
+	if err != nil {
+		t.Fatalf("batch update: %v", err)
+	}
+	_, err = client.BatchDelete(ctx, &pb.BatchDeleteReq{BestEffort: true, Tasks: []*pb.ByIdReq{{Id: two.Id}, {Id: task.Id}}})
+	if err != nil {
+		t.Fatalf("batch delete: %v", err)
+	}
+
+	want = []string{
+		"task.created One",
+		"task.created Two",
+		"task.updated One",
+		"task.completed One",
+		"task.deleted Two",
+	}
+	if got := taken(t, relay, events); !reflect.DeepEqual(got, want) {
+		t.Fatalf("batch calls: expected events %v, got %v", want, got)
+	}
+
+	recurring, err := client.Create(ctx, &pb.Task{Title: "Water plants", Deadline: "2021-12-06", Recurrence: "FREQ=DAILY"})
+	if err != nil {
+		t.Fatalf("create recurring: %v", err)
+	}
+	if _, err := client.ChangeStatus(ctx, &pb.ChangeStatusReq{Id: recurring.Id, Status: "done"}); err != nil {
+		t.Fatalf("complete recurring: %v", err)
+	}
+	want = []string{
+		"task.created Water plants",
+		"task.updated Water plants",
+		"task.completed Water plants",
+		"task.created Water plants",
+	}
+	if got := taken(t, relay, events); !reflect.DeepEqual(got, want) {
+		t.Fatalf("recurring task: expected events %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ToDoService) CreateWorkspace(ctx context.Context, req *pb.Workspace) (*pb.Workspace, error) {
+	if err := requireAdmin(ctx, "workspaces"); err != nil {
+		return nil, err
+	}
+	if err := validateWorkspace(req, false); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		s.logger.Error("failed while generating uuid", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed generate uuid")
+	}
+	req.Id = id.String()
+
+	workspace, err := s.storage.Workspace().Create(*req)
+	if err != nil {
+		return nil, s.workspaceStatus(err, "failed to create workspace")
+	}
+
+	return &workspace, nil
+}
+
+// GetWorkspace is open to the members of the workspace as well as to admins.
+func (s *ToDoService) GetWorkspace(ctx context.Context, req *pb.ByIdReq) (*pb.Workspace, error) {
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+	id, _ := uuid.FromString(req.Id) // already validated
+	if !accessOf(ctx).member(id.String()) {
+		return nil, status.Error(codes.PermissionDenied, "not a member of the workspace")
+	}
+
+	workspace, err := s.storage.Workspace().Get(req.Id)
+	if err != nil {
+		return nil, s.workspaceStatus(err, "failed to get workspace")
+	}
+
+	return &workspace, nil
+}
+
+func (s *ToDoService) ListWorkspaces(ctx context.Context, req *pb.ListWorkspacesReq) (*pb.ListWorkspacesResp, error) {
+	if err := requireAdmin(ctx, "workspaces"); err != nil {
+		return nil, err
+	}
+	if err := checkPage(&req.Page, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	workspaces, err := s.storage.Workspace().List(*req)
+	if err != nil {
+		return nil, s.workspaceStatus(err, "failed to list workspaces")
+	}
+
+	return &workspaces, nil
+}
+
+func (s *ToDoService) UpdateWorkspace(ctx context.Context, req *pb.Workspace) (*pb.Workspace, error) {
+	if err := requireAdmin(ctx, "workspaces"); err != nil {
+		return nil, err
+	}
+	if err := validateWorkspace(req, true); err != nil {
+		return nil, err
+	}
+
+	workspace, err := s.storage.Workspace().Update(*req)
+	if err != nil {
+		return nil, s.workspaceStatus(err, "failed to update workspace")
+	}
+
+	return &workspace, nil
+}
+
+func (s *ToDoService) DeleteWorkspace(ctx context.Context, req *pb.ByIdReq) (*pb.EmptyResp, error) {
+	if err := requireAdmin(ctx, "workspaces"); err != nil {
+		return nil, err
+	}
+	if err := validateID(req.Id); err != nil {
+		return nil, err
+	}
+	if id, _ := uuid.FromString(req.Id); id.String() == repo.DefaultWorkspaceID {
+		return nil, status.Error(codes.FailedPrecondition, "the default workspace can't be deleted")
+	}
+
+	if err := s.storage.Workspace().Delete(req.Id); err != nil {
+		return nil, s.workspaceStatus(err, "failed to delete workspace")
+	}
+
+	return &pb.EmptyResp{}, nil
+}
+
+// workspaceStatus is toStatus for workspace calls, where what can't be found is a workspace
+// and a conflict is a taken name.
+func (s *ToDoService) workspaceStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, repo.ErrNotFound):
+		return status.Error(codes.NotFound, "workspace not found")
+	case errors.Is(err, repo.ErrConflict):
+		return status.Error(codes.AlreadyExists, "workspace name is taken")
+	case errors.Is(err, repo.ErrInUse):
+		return status.Error(codes.FailedPrecondition, "workspace still has projects or tasks")
+	}
+	return s.toStatus(err, msg)
+}
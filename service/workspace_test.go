@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/pkg/auth"
+	"github.com/NafisaTojiboyeva/todo-service/service"
+	"github.com/NafisaTojiboyeva/todo-service/service/servicetest"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func inWorkspace(ctx context.Context, id string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, service.WorkspaceHeader, id)
+}
+
+func TestToDoService_Workspaces(t *testing.T) {
+	client := servicetest.New(t, servicetest.Options{}).Client
+	ctx := context.Background()
+
+	acme, err := client.CreateWorkspace(ctx, &pb.Workspace{Name: "acme"})
+	if err != nil {
+		t.Fatalf("create workspace: %v", err)
+	}
+	if _, err := client.CreateWorkspace(ctx, &pb.Workspace{Name: "acme"}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected a taken name to be refused, got: %v", err)
+	}
+	if _, err := client.CreateWorkspace(ctx, &pb.Workspace{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a name to be required, got: %v", err)
+	}
+	globex, err := client.CreateWorkspace(ctx, &pb.Workspace{Name: "globex"})
+	if err != nil {
+		t.Fatalf("create workspace: %v", err)
+	}
+	list, err := client.ListWorkspaces(ctx, &pb.ListWorkspacesReq{})
+	if err != nil {
+		t.Fatalf("list workspaces: %v", err)
+	}
+	if list.Count != 3 {
+		t.Fatalf("expected the default workspace, acme and globex, got: %v", list.Workspaces)
+	}
+
+	// Tasks are created in the workspace of the request, whatever they name.
+	task, err := client.Create(inWorkspace(ctx, acme.Id), &pb.Task{WorkspaceId: globex.Id, Assignee: "lola", Title: "Acme's"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if task.WorkspaceId != acme.Id {
+		t.Fatalf("expected the task in acme, got %q", task.WorkspaceId)
+	}
+	if _, err := client.Get(inWorkspace(ctx, globex.Id), &pb.ByIdReq{Id: task.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected acme's task to be hidden from globex, got: %v", err)
+	}
+	if _, err := client.Delete(inWorkspace(ctx, globex.Id), &pb.ByIdReq{Id: task.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected globex not to delete acme's task, got: %v", err)
+	}
+	for name, ctx := range map[string]context.Context{"default": ctx, "globex": inWorkspace(ctx, globex.Id)} {
+		tasks, err := client.List(ctx, &pb.ListReq{Page: 1, Limit: 10})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if tasks.Count != 0 {
+			t.Errorf("%s: expected no tasks, got: %v", name, tasks.Tasks)
+		}
+	}
+
+	if _, err := client.List(inWorkspace(ctx, "acme"), &pb.ListReq{Page: 1, Limit: 10}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a workspace id to be a UUID, got: %v", err)
+	}
+	if _, err := client.List(inWorkspace(ctx, task.Id), &pb.ListReq{Page: 1, Limit: 10}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected an unknown workspace not to be found, got: %v", err)
+	}
+
+	if _, err := client.DeleteWorkspace(ctx, &pb.ByIdReq{Id: acme.Id}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected a workspace with tasks to be kept, got: %v", err)
+	}
+	if _, err := client.DeleteWorkspace(ctx, &pb.ByIdReq{Id: repo.DefaultWorkspaceID}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected the default workspace to be kept, got: %v", err)
+	}
+	if _, err := client.DeleteWorkspace(ctx, &pb.ByIdReq{Id: globex.Id}); err != nil {
+		t.Fatalf("delete workspace: %v", err)
+	}
+	if _, err := client.GetWorkspace(ctx, &pb.ByIdReq{Id: globex.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected globex to be gone, got: %v", err)
+	}
+}
+
+func TestToDoService_WorkspaceMembers(t *testing.T) {
+	server := servicetest.New(t, servicetest.Options{})
+	acme, err := server.Storage.Workspace().Create(pb.Workspace{Id: "9f1c8e2a-4f5b-4c7d-9a3e-2b6d8c1f0e4a", Name: "acme"})
+	if err != nil {
+		t.Fatalf("create workspace: %v", err)
+	}
+
+	keys, err := auth.ParseAPIKeys("lola:lola-key::" + acme.Id + ",bob:bob-key,ops:ops-key:admin")
+	if err != nil {
+		t.Fatalf("parse keys: %v", err)
+	}
+	authenticator, err := auth.NewAuthenticator(auth.Options{APIKeys: keys})
+	if err != nil {
+		t.Fatalf("new authenticator: %v", err)
+	}
+	client := servicetest.New(t, servicetest.Options{
+		Storage:            server.Storage,
+		UnaryInterceptors:  []grpc.UnaryServerInterceptor{authenticator.UnaryServerInterceptor()},
+		StreamInterceptors: []grpc.StreamServerInterceptor{authenticator.StreamServerInterceptor()},
+	}).Client
+	as := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyHeader, key)
+	}
+	lola, bob, ops := as("lola-key"), as("bob-key"), as("ops-key")
+
+	if _, err := client.Create(inWorkspace(lola, acme.Id), &pb.Task{Assignee: "bob", Title: "For bob"}); err != nil {
+		t.Fatalf("expected a member to work in her workspace: %v", err)
+	}
+	if _, err := client.Create(lola, &pb.Task{Assignee: "lola", Title: "Default"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected a member of acme to stay out of the default workspace, got: %v", err)
+	}
+	// Being assigned a task doesn't let bob into its workspace.
+	if _, err := client.List(inWorkspace(bob, acme.Id), &pb.ListReq{Page: 1, Limit: 10}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected bob to be kept out of acme, got: %v", err)
+	}
+	if _, err := client.Create(bob, &pb.Task{Assignee: "bob", Title: "Default"}); err != nil {
+		t.Fatalf("expected bob to work in the default workspace: %v", err)
+	}
+
+	if _, err := client.GetWorkspace(lola, &pb.ByIdReq{Id: acme.Id}); err != nil {
+		t.Fatalf("expected a member to get her workspace: %v", err)
+	}
+	if _, err := client.GetWorkspace(bob, &pb.ByIdReq{Id: acme.Id}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected bob not to get acme, got: %v", err)
+	}
+	if _, err := client.CreateWorkspace(lola, &pb.Workspace{Name: "lola's"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected workspaces to be created by admins only, got: %v", err)
+	}
+
+	list, err := client.List(inWorkspace(ops, acme.Id), &pb.ListReq{Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if list.Count != 1 {
+		t.Fatalf("expected an admin to see the task of acme, got: %v", list.Tasks)
+	}
+}
@@ -0,0 +1,54 @@
+package memory
+
+import (
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+func (r *taskRepo) BatchCreate(tasks []pb.Task, atomic bool) ([]repo.BatchResult, error) {
+	return r.batch(len(tasks), atomic, func(i int) (pb.Task, error) {
+		return r.create(tasks[i])
+	})
+}
+
+func (r *taskRepo) BatchUpdate(patches []repo.TaskPatch, atomic bool) ([]repo.BatchResult, error) {
+	return r.batch(len(patches), atomic, func(i int) (pb.Task, error) {
+		return r.patch(patches[i].Task, patches[i].Fields)
+	})
+}
+
+func (r *taskRepo) BatchDelete(items []pb.ByIdReq, atomic bool) ([]repo.BatchResult, error) {
+	return r.batch(len(items), atomic, func(i int) (pb.Task, error) {
+		return pb.Task{Id: items[i].Id}, r.delete(items[i].Id, items[i].Version)
+	})
+}
+
+// batch runs fn for every item under one write lock. When atomic, the first failure
+// puts back the tasks, the outbox and the history as they were before the batch.
+func (r *taskRepo) batch(n int, atomic bool, fn func(i int) (pb.Task, error)) ([]repo.BatchResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var before map[string]record
+	events, changes := len(r.outbox), len(r.history)
+	if atomic {
+		before = make(map[string]record, len(r.tasks))
+		for id, rec := range r.tasks {
+			before[id] = rec
+		}
+	}
+
+	results := make([]repo.BatchResult, n)
+	for i := 0; i < n; i++ {
+		task, err := fn(i)
+		if err != nil && atomic {
+			r.tasks = before
+			r.outbox = r.outbox[:events]
+			r.history = r.history[:changes]
+			return nil, &repo.BatchItemError{Index: i, Err: err}
+		}
+		results[i] = repo.BatchResult{Task: task, Err: err}
+	}
+
+	return results, nil
+}
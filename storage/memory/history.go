@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"strconv"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+func (r *taskRepo) History(req pb.TaskHistoryReq) (pb.TaskHistoryResp, error) {
+	id, err := parseID(req.TaskId)
+	if err != nil {
+		return pb.TaskHistoryResp{}, err
+	}
+	if err := checkPage(req.Page, req.Limit); err != nil {
+		return pb.TaskHistoryResp{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var changes []pb.TaskChange
+	for i := len(r.history) - 1; i >= 0; i-- {
+		entry := r.history[i]
+		if entry.TaskId != id {
+			continue
+		}
+		// A limited repository finds no history for the tasks it may not see. The task as
+		// of its latest change decides, so the history outlives a purge.
+		if len(changes) == 0 && r.limited() && !r.sees(entry.task) {
+			return pb.TaskHistoryResp{}, nil
+		}
+		changes = append(changes, entry.TaskChange)
+	}
+
+	start, end := pageBounds(len(changes), req.Page, req.Limit)
+	resp := pb.TaskHistoryResp{Count: int64(len(changes))}
+	for _, change := range changes[start:end] {
+		change := change
+		change.Fields = append([]*pb.FieldChange(nil), change.Fields...)
+		resp.Changes = append(resp.Changes, &change)
+	}
+
+	return resp, nil
+}
+
+// historyEntry is a row of task_history: a change, and the workspace, owner and assignee
+// of the task after it.
+type historyEntry struct {
+	pb.TaskChange
+	task record
+}
+
+// record adds the change from before to after to the history. Callers hold the write lock.
+func (r *taskRepo) record(action string, before, after pb.Task) {
+	change := pb.TaskChange{
+		Id:      strconv.Itoa(len(r.history) + 1),
+		TaskId:  after.Id,
+		Action:  action,
+		Actor:   r.actor,
+		Fields:  repo.FieldChanges(before, after),
+		Version: after.Version,
+	}
+	_, change.ChangedTime = timestamp(r.now())
+	r.history = append(r.history, historyEntry{
+		TaskChange: change,
+		task:       record{workspaceID: after.WorkspaceId, owner: after.Owner, assignee: after.Assignee},
+	})
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+type outboxRepo struct {
+	tasks *taskRepo
+
+	mu       sync.Mutex
+	relaying bool
+}
+
+// NewOutboxRepo returns an in-memory outbox repository for the events of tasks.
+func NewOutboxRepo(tasks *taskRepo) *outboxRepo {
+	return &outboxRepo{tasks: tasks}
+}
+
+// Relay publishes without holding the task lock, so publish may read and change tasks.
+// Only the number of delivered events is kept, not when they were delivered. The events
+// of a relay that never returns are gone with the process, so there is no lease to run out.
+func (r *outboxRepo) Relay(limit int, _ time.Time, _ time.Duration, publish func(events []repo.Event) int) (int, error) {
+	r.mu.Lock()
+	if r.relaying {
+		r.mu.Unlock()
+		return 0, nil
+	}
+	r.relaying = true
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		r.relaying = false
+		r.mu.Unlock()
+	}()
+
+	r.tasks.mu.RLock()
+	pending := r.tasks.outbox[r.tasks.delivered:]
+	if len(pending) > limit {
+		pending = pending[:limit]
+	}
+	events := append([]repo.Event(nil), pending...)
+	r.tasks.mu.RUnlock()
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	n := publish(events)
+	if n <= 0 {
+		return 0, nil
+	}
+	if n > len(events) {
+		n = len(events)
+	}
+
+	r.tasks.mu.Lock()
+	for i := r.tasks.delivered; i < r.tasks.delivered+n; i++ {
+		r.tasks.outbox[i].Revision = int64(i + 1)
+	}
+	r.tasks.delivered += n
+	r.tasks.mu.Unlock()
+
+	return n, nil
+}
+
+// Since relies on the outbox being delivered in order: the event with revision n is the
+// nth one.
+func (r *outboxRepo) Since(revision int64, limit int) ([]repo.Event, error) {
+	r.tasks.mu.RLock()
+	defer r.tasks.mu.RUnlock()
+
+	start, end := int(revision), r.tasks.delivered
+	if start < 0 {
+		start = 0
+	}
+	if start > end {
+		start = end
+	}
+	if end-start > limit {
+		end = start + limit
+	}
+
+	return append([]repo.Event(nil), r.tasks.outbox[start:end]...), nil
+}
+
+func (r *outboxRepo) LastRevision() (int64, error) {
+	r.tasks.mu.RLock()
+	defer r.tasks.mu.RUnlock()
+
+	return int64(r.tasks.delivered), nil
+}
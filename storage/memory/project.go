@@ -0,0 +1,217 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// Column sizes of projects, see migrations/.
+var projectMaxLen = map[string]int{
+	"name":        100,
+	"description": 500,
+	"color":       7,
+}
+
+// projectRecord is one row of projects.
+type projectRecord struct {
+	id          string
+	workspaceID string
+	name        string
+	description string
+	color       string
+	archived    bool
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// projectRepo keeps its projects with the tasks of tasks, so that tasks can only be put in
+// projects of their workspace and projects with tasks can't be deleted.
+type projectRepo struct {
+	tasks *taskRepo
+
+	// workspace limits the repository to the projects of a workspace when set.
+	workspace string
+}
+
+// NewProjectRepo returns an empty in-memory project repository for the tasks of tasks.
+func NewProjectRepo(tasks *taskRepo) *projectRepo {
+	return &projectRepo{tasks: tasks}
+}
+
+func (r *projectRepo) InWorkspace(id string) repo.ProjectStorageI {
+	scoped := *r
+	scoped.workspace = id
+	return &scoped
+}
+
+func (r *projectRepo) Create(project pb.Project) (pb.Project, error) {
+	id, err := parseID(project.Id)
+	if err != nil {
+		return pb.Project{}, err
+	}
+
+	r.tasks.mu.Lock()
+	defer r.tasks.mu.Unlock()
+
+	workspace := project.WorkspaceId
+	if r.workspace != "" {
+		workspace = r.workspace
+	}
+	if workspace == "" {
+		workspace = repo.DefaultWorkspaceID
+	}
+	rec := projectRecord{name: project.Name, description: project.Description, color: project.Color, archived: project.Archived}
+	if rec.workspaceID, err = r.tasks.parseWorkspaceID(workspace); err != nil {
+		return pb.Project{}, err
+	}
+	if err := r.check(id, rec); err != nil {
+		return pb.Project{}, err
+	}
+	if _, ok := r.tasks.projects[id]; ok {
+		return pb.Project{}, fmt.Errorf(`%w: duplicate key value violates unique constraint "projects_pkey"`, repo.ErrConflict)
+	}
+
+	now := utc(r.tasks.now())
+	rec.id, rec.createdAt, rec.updatedAt = id, now, now
+	r.tasks.projects[id] = rec
+
+	return rec.project(), nil
+}
+
+func (r *projectRepo) Get(id string) (pb.Project, error) {
+	id, err := parseID(id)
+	if err != nil {
+		return pb.Project{}, err
+	}
+
+	r.tasks.mu.RLock()
+	defer r.tasks.mu.RUnlock()
+
+	rec, ok := r.tasks.projects[id]
+	if !ok || !r.sees(rec) {
+		return pb.Project{}, repo.ErrNotFound
+	}
+
+	return rec.project(), nil
+}
+
+func (r *projectRepo) List(req pb.ListProjectsReq) (pb.ListProjectsResp, error) {
+	if err := checkPage(req.Page, req.Limit); err != nil {
+		return pb.ListProjectsResp{}, err
+	}
+
+	r.tasks.mu.RLock()
+	defer r.tasks.mu.RUnlock()
+
+	var recs []projectRecord
+	for _, rec := range r.tasks.projects {
+		if r.sees(rec) && (req.IncludeArchived || !rec.archived) {
+			recs = append(recs, rec)
+		}
+	}
+	sort.Slice(recs, func(i, j int) bool {
+		if c := compareTimes(recs[i].createdAt, recs[j].createdAt); c != 0 {
+			return c < 0
+		}
+		return recs[i].id < recs[j].id
+	})
+
+	start, end := pageBounds(len(recs), req.Page, req.Limit)
+	resp := pb.ListProjectsResp{Count: int64(len(recs))}
+	for _, rec := range recs[start:end] {
+		project := rec.project()
+		resp.Projects = append(resp.Projects, &project)
+	}
+
+	return resp, nil
+}
+
+func (r *projectRepo) Update(project pb.Project) (pb.Project, error) {
+	id, err := parseID(project.Id)
+	if err != nil {
+		return pb.Project{}, err
+	}
+
+	r.tasks.mu.Lock()
+	defer r.tasks.mu.Unlock()
+
+	rec, ok := r.tasks.projects[id]
+	if !ok || !r.sees(rec) {
+		return pb.Project{}, repo.ErrNotFound
+	}
+	rec.name, rec.description, rec.color, rec.archived = project.Name, project.Description, project.Color, project.Archived
+	if err := r.check(id, rec); err != nil {
+		return pb.Project{}, err
+	}
+
+	rec.updatedAt = utc(r.tasks.now())
+	r.tasks.projects[id] = rec
+
+	return rec.project(), nil
+}
+
+func (r *projectRepo) Delete(id string) error {
+	id, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	r.tasks.mu.Lock()
+	defer r.tasks.mu.Unlock()
+
+	rec, ok := r.tasks.projects[id]
+	if !ok || !r.sees(rec) {
+		return repo.ErrNotFound
+	}
+	for _, task := range r.tasks.tasks {
+		if task.projectID == id {
+			return repo.ErrInUse
+		}
+	}
+	delete(r.tasks.projects, id)
+
+	return nil
+}
+
+// sees tells whether rec is among the projects the repository is limited to.
+func (r *projectRepo) sees(rec projectRecord) bool {
+	return r.workspace == "" || rec.workspaceID == r.workspace
+}
+
+// check enforces the column sizes of projects and the uniqueness of names in a workspace.
+// Callers hold the lock.
+func (r *projectRepo) check(id string, rec projectRecord) error {
+	values := map[string]string{"name": rec.name, "description": rec.description, "color": rec.color}
+	for field, value := range values {
+		if utf8.RuneCountInString(value) > projectMaxLen[field] {
+			return &repo.FieldError{Field: field, Description: fmt.Sprintf("value too long for type character varying(%d)", projectMaxLen[field])}
+		}
+	}
+
+	for _, other := range r.tasks.projects {
+		if other.id != id && other.workspaceID == rec.workspaceID && other.name == rec.name {
+			return fmt.Errorf(`%w: duplicate key value violates unique constraint "projects_workspace_id_name_key"`, repo.ErrConflict)
+		}
+	}
+
+	return nil
+}
+
+func (rec projectRecord) project() pb.Project {
+	project := pb.Project{
+		Id:          rec.id,
+		WorkspaceId: rec.workspaceID,
+		Name:        rec.name,
+		Description: rec.description,
+		Color:       rec.color,
+		Archived:    rec.archived,
+	}
+	_, project.CreatedTime = timestamp(rec.createdAt)
+	_, project.UpdatedTime = timestamp(rec.updatedAt)
+	return project
+}
@@ -0,0 +1,251 @@
+package memory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// sortColumn returns the todos column sort_by names, the same way the postgres repo maps them.
+func sortColumn(sortBy string) (string, bool) {
+	switch sortBy {
+	case "":
+		return "created_at", true
+	case "created_at", "updated_at", "deadline", "title", "assignee", "status":
+		return sortBy, true
+	}
+
+	return "", false
+}
+
+// listFilter turns the filters of req into a predicate over records, live or soft-deleted ones.
+func listFilter(deleted bool, req pb.ListReq) (func(record) bool, error) {
+	bounds := []struct {
+		field string
+		value string
+		after bool
+		of    func(record) time.Time
+	}{
+		{"deadline_from", req.DeadlineFrom, true, func(rec record) time.Time { return rec.deadline }},
+		{"deadline_to", req.DeadlineTo, false, func(rec record) time.Time { return rec.deadline }},
+		{"created_from", req.CreatedFrom, true, func(rec record) time.Time { return rec.createdAt }},
+		{"created_to", req.CreatedTo, false, func(rec record) time.Time { return rec.createdAt }},
+	}
+
+	var conds []func(record) bool
+	for _, b := range bounds {
+		if b.value == "" {
+			continue
+		}
+		bound, err := parseTimestamp(b.field, b.value)
+		if err != nil {
+			return nil, err
+		}
+		of, after := b.of, b.after
+		conds = append(conds, func(rec record) bool {
+			t := of(rec)
+			if t.IsZero() {
+				return false // NULL never matches
+			}
+			if after {
+				return !t.Before(bound)
+			}
+			return !t.After(bound)
+		})
+	}
+
+	return func(rec record) bool {
+		if rec.deleted() != deleted {
+			return false
+		}
+		if req.Assignee != "" && rec.assignee != req.Assignee {
+			return false
+		}
+		if req.Status != "" && rec.status != req.Status {
+			return false
+		}
+		if req.SeriesId != "" && rec.seriesID != strings.ToLower(req.SeriesId) {
+			return false
+		}
+		if req.ProjectId != "" && rec.projectID != strings.ToLower(req.ProjectId) {
+			return false
+		}
+		for _, cond := range conds {
+			if !cond(rec) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// descending tells whether sortOrder sorts from the last to the first: asc, the
+// default, or desc in any case.
+func descending(sortOrder string) (bool, error) {
+	switch strings.ToLower(sortOrder) {
+	case "", "asc":
+		return false, nil
+	case "desc":
+		return true, nil
+	}
+	return false, &repo.FieldError{Field: "sort_order", Description: fmt.Sprintf("unsupported sort order %q", sortOrder)}
+}
+
+// orderBy returns the less function of ORDER BY column NULLS LAST, id.
+func orderBy(sortBy, sortOrder string) (func(a, b record) bool, error) {
+	column, ok := sortColumn(sortBy)
+	if !ok {
+		return nil, &repo.FieldError{Field: "sort_by", Description: fmt.Sprintf("unsupported sort field %q", sortBy)}
+	}
+
+	desc, err := descending(sortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	compare := func(a, b record) int {
+		switch column {
+		case "created_at":
+			return compareTimes(a.createdAt, b.createdAt)
+		case "updated_at":
+			return compareTimes(a.updatedAt, b.updatedAt)
+		case "deadline":
+			return compareTimes(a.deadline, b.deadline)
+		case "title":
+			return strings.Compare(a.title, b.title)
+		case "assignee":
+			return strings.Compare(a.assignee, b.assignee)
+		}
+		return strings.Compare(a.status, b.status)
+	}
+	nullOf := func(rec record) bool {
+		switch column {
+		case "updated_at":
+			return rec.updatedAt.IsZero()
+		case "deadline":
+			return rec.deadline.IsZero()
+		}
+		return false
+	}
+
+	return func(a, b record) bool {
+		if aNull, bNull := nullOf(a), nullOf(b); aNull != bNull {
+			return bNull
+		}
+		c := compare(a, b)
+		if c == 0 {
+			c = strings.Compare(a.id, b.id)
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	}, nil
+}
+
+// byCreatedAt orders by (created_at, id), the keyset of page tokens.
+func byCreatedAt(desc bool) func(a, b record) bool {
+	return func(a, b record) bool {
+		c := compareTimes(a.createdAt, b.createdAt)
+		if c == 0 {
+			c = strings.Compare(a.id, b.id)
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	}
+}
+
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+// checkPage rejects what Postgres refuses as LIMIT and OFFSET.
+func checkPage(page, limit int64) error {
+	if page < 1 {
+		return &repo.FieldError{Field: "page", Description: "OFFSET must not be negative"}
+	}
+	if limit < 0 {
+		return &repo.FieldError{Field: "limit", Description: "LIMIT must not be negative"}
+	}
+
+	return nil
+}
+
+// pageBounds returns the slice bounds of page of size limit within n items.
+func pageBounds(n int, page, limit int64) (int, int) {
+	start := (page - 1) * limit
+	if start > int64(n) {
+		start = int64(n)
+	}
+	end := start + limit
+	if end > int64(n) {
+		end = int64(n)
+	}
+
+	return int(start), int(end)
+}
+
+func listByOffset(recs []record, less func(a, b record) bool, page, limit int64) (pb.ListResp, error) {
+	if err := checkPage(page, limit); err != nil {
+		return pb.ListResp{}, err
+	}
+
+	sortRecords(recs, less)
+	start, end := pageBounds(len(recs), page, limit)
+	tasks := make([]*pb.Task, 0, end-start)
+	for _, rec := range recs[start:end] {
+		tasks = append(tasks, rec.listed())
+	}
+
+	return pb.ListResp{
+		Tasks: tasks,
+		Count: int64(len(recs)),
+	}, nil
+}
+
+// listByCursor returns the page that follows token in (created_at, id) order.
+func listByCursor(recs []record, token string, desc bool, limit int64) (pb.ListResp, error) {
+	if limit <= 0 {
+		return pb.ListResp{}, &repo.FieldError{Field: "limit", Description: "must be positive when paging with tokens"}
+	}
+
+	less := byCreatedAt(desc)
+	if token != "" {
+		after, err := repo.DecodePageToken(token)
+		if err != nil {
+			return pb.ListResp{}, err
+		}
+		last := record{createdAt: after.CreatedAt, id: after.ID}
+		var rest []record
+		for _, rec := range recs {
+			if less(last, rec) {
+				rest = append(rest, rec)
+			}
+		}
+		recs = rest
+	}
+
+	sortRecords(recs, less)
+	var resp pb.ListResp
+	if int64(len(recs)) > limit {
+		recs = recs[:limit]
+		last := recs[len(recs)-1]
+		resp.NextPageToken = repo.PageToken{CreatedAt: last.createdAt, ID: last.id}.Encode()
+	}
+	for _, rec := range recs {
+		resp.Tasks = append(resp.Tasks, rec.listed())
+	}
+
+	return resp, nil
+}
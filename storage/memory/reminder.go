@@ -0,0 +1,113 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// reminderRecord is one row of reminders. A zero retryAt stands for NULL.
+type reminderRecord struct {
+	sentAt   time.Time
+	attempts int
+	retryAt  time.Time
+	failed   bool
+}
+
+// retried tells whether the failed delivery of the reminder is to be retried by now.
+func (rec reminderRecord) retried(now time.Time) bool {
+	return !rec.retryAt.IsZero() && !rec.retryAt.After(now)
+}
+
+type reminderRepo struct {
+	tasks *taskRepo
+
+	mu   sync.Mutex
+	sent map[repo.Reminder]reminderRecord
+}
+
+// NewReminderRepo returns an in-memory reminder repository for the tasks of tasks.
+func NewReminderRepo(tasks *taskRepo) *reminderRepo {
+	return &reminderRepo{tasks: tasks, sent: map[repo.Reminder]reminderRecord{}}
+}
+
+func (r *reminderRepo) Due(kind repo.ReminderKind, from, to, now time.Time, limit int) ([]pb.Task, error) {
+	from, to = utc(from), utc(to)
+
+	r.tasks.mu.RLock()
+	recs := r.tasks.filter(func(rec record) bool {
+		return !rec.deleted() && rec.status != "done" && rec.status != "cancelled" &&
+			!rec.deadline.IsZero() && !rec.deadline.Before(from) && rec.deadline.Before(to)
+	})
+	r.tasks.mu.RUnlock()
+
+	sort.Slice(recs, func(i, j int) bool {
+		if c := compareTimes(recs[i].deadline, recs[j].deadline); c != 0 {
+			return c < 0
+		}
+		return recs[i].id < recs[j].id
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var tasks []pb.Task
+	for _, rec := range recs {
+		if len(tasks) == limit {
+			break
+		}
+		if sent, ok := r.sent[repo.Reminder{TaskID: rec.id, Kind: kind, Deadline: rec.deadline}]; !ok || sent.retried(now) {
+			tasks = append(tasks, rec.task())
+		}
+	}
+
+	return tasks, nil
+}
+
+func (r *reminderRepo) Claim(reminder repo.Reminder, at time.Time) (int, error) {
+	reminder, err := key(reminder)
+	if err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.sent[reminder]
+	if ok && !rec.retried(at) {
+		return 0, nil
+	}
+	rec = reminderRecord{sentAt: utc(at), attempts: rec.attempts + 1}
+	r.sent[reminder] = rec
+
+	return rec.attempts, nil
+}
+
+func (r *reminderRepo) Fail(reminder repo.Reminder, retryAt time.Time) error {
+	reminder, err := key(reminder)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if rec, ok := r.sent[reminder]; ok {
+		rec.retryAt, rec.failed = utc(retryAt), retryAt.IsZero()
+		r.sent[reminder] = rec
+	}
+	return nil
+}
+
+// key puts r in the form the sent map is keyed by, the way the reminders columns store it.
+func key(r repo.Reminder) (repo.Reminder, error) {
+	id, err := parseID(r.TaskID)
+	if err != nil {
+		return repo.Reminder{}, err
+	}
+
+	return repo.Reminder{TaskID: id, Kind: r.Kind, Deadline: utc(r.Deadline)}, nil
+}
@@ -0,0 +1,186 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// Weights of title and summary matches, the defaults of ts_rank for the A and B labels
+// todos.search gives them.
+const (
+	titleWeight   = 1.0
+	summaryWeight = 0.4
+)
+
+// Search approximates the postgres full-text search without stemming or stop words:
+// a task matches when its title or summary has every word of the query, and none of
+// the words prefixed with "-".
+func (r *taskRepo) Search(req pb.SearchReq) (pb.SearchResp, error) {
+	include, exclude := parseQuery(req.Query)
+
+	var results []*pb.SearchResult
+	r.mu.RLock()
+	counts := r.progress()
+	for _, rec := range r.tasks {
+		if rec.deleted() || !r.sees(rec) || req.Assignee != "" && rec.assignee != req.Assignee || req.Status != "" && rec.status != req.Status {
+			continue
+		}
+		rec.progress = counts[rec.id]
+		if result, ok := match(rec, include, exclude); ok {
+			results = append(results, result)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Rank != b.Rank {
+			return a.Rank > b.Rank
+		}
+		return a.Task.Id < b.Task.Id
+	})
+
+	if req.PageToken != "" || req.Page == 0 {
+		return searchByCursor(results, req.PageToken, req.Limit)
+	}
+
+	if err := checkPage(req.Page, req.Limit); err != nil {
+		return pb.SearchResp{}, err
+	}
+	start, end := pageBounds(len(results), req.Page, req.Limit)
+
+	return pb.SearchResp{
+		Results: results[start:end],
+		Count:   int64(len(results)),
+	}, nil
+}
+
+// searchByCursor returns the page of sorted results that follows token.
+func searchByCursor(results []*pb.SearchResult, token string, limit int64) (pb.SearchResp, error) {
+	if limit <= 0 {
+		return pb.SearchResp{}, &repo.FieldError{Field: "limit", Description: "must be positive when paging with tokens"}
+	}
+
+	if token != "" {
+		after, err := repo.DecodeSearchToken(token)
+		if err != nil {
+			return pb.SearchResp{}, err
+		}
+		next := sort.Search(len(results), func(i int) bool {
+			r := results[i]
+			return r.Rank < after.Rank || r.Rank == after.Rank && r.Task.Id > after.ID
+		})
+		results = results[next:]
+	}
+
+	var resp pb.SearchResp
+	if int64(len(results)) > limit {
+		results = results[:limit]
+		last := results[len(results)-1]
+		resp.NextPageToken = repo.SearchToken{Rank: last.Rank, ID: last.Task.Id}.Encode()
+	}
+	resp.Results = results
+
+	return resp, nil
+}
+
+func parseQuery(query string) (include, exclude []string) {
+	for _, field := range strings.Fields(strings.ToLower(query)) {
+		negated := strings.HasPrefix(field, "-")
+		for _, word := range words(field) {
+			if negated {
+				exclude = append(exclude, word)
+			} else {
+				include = append(include, word)
+			}
+		}
+	}
+
+	return include, exclude
+}
+
+func match(rec record, include, exclude []string) (*pb.SearchResult, bool) {
+	if len(include) == 0 {
+		return nil, false
+	}
+
+	title, summary := counts(rec.title), counts(rec.summary)
+	for _, word := range exclude {
+		if title[word]+summary[word] > 0 {
+			return nil, false
+		}
+	}
+
+	var rank float32
+	for _, word := range include {
+		if title[word]+summary[word] == 0 {
+			return nil, false
+		}
+		rank += titleWeight*float32(title[word]) + summaryWeight*float32(summary[word])
+	}
+
+	return &pb.SearchResult{
+		Task:           rec.listed(),
+		TitleSnippet:   highlight(rec.title, include),
+		SummarySnippet: highlight(rec.summary, include),
+		Rank:           rank,
+	}, true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return !isWordRune(r) })
+}
+
+func counts(s string) map[string]int {
+	n := map[string]int{}
+	for _, word := range words(strings.ToLower(s)) {
+		n[word]++
+	}
+
+	return n
+}
+
+// highlight wraps the words of s that are in include with <b></b>, like ts_headline does.
+func highlight(s string, include []string) string {
+	var (
+		b     strings.Builder
+		start = -1
+	)
+	flush := func(end int) {
+		word := s[start:end]
+		for _, w := range include {
+			if strings.ToLower(word) == w {
+				word = "<b>" + word + "</b>"
+				break
+			}
+		}
+		b.WriteString(word)
+		start = -1
+	}
+
+	for i, r := range s {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			flush(i)
+		}
+		b.WriteRune(r)
+	}
+	if start >= 0 {
+		flush(len(s))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+type storage struct {
+	taskRepo      *taskRepo
+	reminderRepo  repo.ReminderStorageI
+	webhookRepo   repo.WebhookStorageI
+	outboxRepo    repo.OutboxStorageI
+	workspaceRepo repo.WorkspaceStorageI
+	projectRepo   repo.ProjectStorageI
+}
+
+// NewStorage returns a storage of the tasks of tasks, that keeps everything else in
+// memory too, starting empty. Tests set the clock of tasks before they hand it over.
+func NewStorage(tasks *taskRepo) *storage {
+	return &storage{
+		taskRepo:      tasks,
+		reminderRepo:  NewReminderRepo(tasks),
+		webhookRepo:   NewWebhookRepo(),
+		outboxRepo:    NewOutboxRepo(tasks),
+		workspaceRepo: NewWorkspaceRepo(tasks),
+		projectRepo:   NewProjectRepo(tasks),
+	}
+}
+
+func (s storage) Task() repo.TaskStorageI {
+	return s.taskRepo
+}
+
+func (s storage) Reminder() repo.ReminderStorageI {
+	return s.reminderRepo
+}
+
+func (s storage) Webhook() repo.WebhookStorageI {
+	return s.webhookRepo
+}
+
+func (s storage) Outbox() repo.OutboxStorageI {
+	return s.outboxRepo
+}
+
+func (s storage) Workspace() repo.WorkspaceStorageI {
+	return s.workspaceRepo
+}
+
+func (s storage) Project() repo.ProjectStorageI {
+	return s.projectRepo
+}
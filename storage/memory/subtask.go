@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+)
+
+// progress rolls up the live subtasks of a task.
+type progress struct {
+	total int64
+	done  int64
+}
+
+func (r *taskRepo) ListSubtasks(req pb.ListSubtasksReq) (pb.ListResp, error) {
+	id, err := parseID(req.ParentId)
+	if err != nil {
+		return pb.ListResp{}, err
+	}
+	if err := checkPage(req.Page, req.Limit); err != nil {
+		return pb.ListResp{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	parent, ok := r.tasks[id]
+	if !ok || parent.deleted() || !r.sees(parent) {
+		return pb.ListResp{}, repo.ErrNotFound
+	}
+
+	// The tree is walked through every subtask, but lists only those the repository sees.
+	var recs []record
+	counts := r.progress()
+	for _, rec := range r.subtasks(id, time.Time{}, req.Recursive) {
+		if r.sees(rec) {
+			rec.progress = counts[rec.id]
+			recs = append(recs, rec)
+		}
+	}
+
+	start, end := pageBounds(len(recs), req.Page, req.Limit)
+	resp := pb.ListResp{Count: int64(len(recs))}
+	for _, rec := range recs[start:end] {
+		resp.Tasks = append(resp.Tasks, rec.listed())
+	}
+
+	return resp, nil
+}
+
+// subtasks returns the subtasks of task id whose deleted_at is deletedAt, the zero time
+// for live ones: its direct subtasks, or all of them down the tree when recursive. They
+// come shallowest first, then oldest first. Callers hold the lock.
+func (r *taskRepo) subtasks(id string, deletedAt time.Time, recursive bool) []record {
+	depths := map[string]int{}
+	var found []record
+	parents := map[string]bool{id: true}
+	for depth := 1; len(parents) > 0; depth++ {
+		children := map[string]bool{}
+		for _, rec := range r.tasks {
+			if _, seen := depths[rec.id]; !seen && parents[rec.parentID] && rec.deletedAt.Equal(deletedAt) {
+				depths[rec.id] = depth
+				found = append(found, rec)
+				children[rec.id] = true
+			}
+		}
+		if !recursive {
+			break
+		}
+		parents = children
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		a, b := found[i], found[j]
+		if depths[a.id] != depths[b.id] {
+			return depths[a.id] < depths[b.id]
+		}
+		if c := compareTimes(a.createdAt, b.createdAt); c != 0 {
+			return c < 0
+		}
+		return strings.Compare(a.id, b.id) < 0
+	})
+	return found
+}
+
+// progress counts the live subtasks of every task that has some. Callers hold the lock.
+func (r *taskRepo) progress() map[string]progress {
+	counts := map[string]progress{}
+	for _, rec := range r.tasks {
+		if rec.parentID == "" || rec.deleted() {
+			continue
+		}
+		p := counts[rec.parentID]
+		p.total++
+		if rec.status == "done" {
+			p.done++
+		}
+		counts[rec.parentID] = p
+	}
+	return counts
+}
+
+// withProgress returns rec with the progress of its subtasks. Callers hold the lock.
+func (r *taskRepo) withProgress(rec record) record {
+	rec.progress = r.progress()[rec.id]
+	return rec
+}
+
+// purge removes task id along with its subtasks at any depth, and returns how many tasks
+// it removed, subtasks included. Callers hold the write lock.
+func (r *taskRepo) purge(id string) int64 {
+	if _, ok := r.tasks[id]; !ok {
+		return 0
+	}
+	purged := int64(1)
+	for _, rec := range r.tasks {
+		if rec.parentID == id {
+			purged += r.purge(rec.id)
+		}
+	}
+	delete(r.tasks, id)
+	return purged
+}
+
+// parseParentID returns id in canonical form, checking that rec may be put under it: it
+// must be a live task of the workspace of rec that the repository sees, and neither rec
+// itself nor one of its subtasks. An empty id stands for NULL.
+func (r *taskRepo) parseParentID(id string, rec record) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return "", &repo.FieldError{Field: "parent_id", Description: fmt.Sprintf("invalid input syntax for type uuid: %q", id)}
+	}
+	parent, ok := r.tasks[parsed.String()]
+	if !ok || parent.deleted() || !r.sees(parent) {
+		return "", &repo.FieldError{Field: "parent_id", Description: "no such task"}
+	}
+	if parent.workspaceID != rec.workspaceID {
+		return "", &repo.FieldError{Field: "parent_id", Description: `insert or update on table "todos" violates foreign key constraint "todos_parent_fkey"`}
+	}
+	seen := map[string]bool{}
+	for ancestor := parent.id; ancestor != "" && !seen[ancestor]; ancestor = r.tasks[ancestor].parentID {
+		if ancestor == rec.id {
+			return "", &repo.FieldError{Field: "parent_id", Description: "a task can't be a subtask of itself or of its own subtasks"}
+		}
+		seen[ancestor] = true
+	}
+	return parsed.String(), nil
+}
@@ -0,0 +1,767 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/gogo/protobuf/types"
+)
+
+// Column sizes and statuses allowed by the todos table, see migrations/.
+var (
+	maxLen = map[string]int{
+		"assignee":   50,
+		"title":      50,
+		"summary":    100,
+		"time_zone":  64,
+		"recurrence": 200,
+		"owner":      255,
+	}
+	statuses = map[string]bool{
+		"todo":        true,
+		"in_progress": true,
+		"blocked":     true,
+		"done":        true,
+		"cancelled":   true,
+	}
+)
+
+// timestampLayouts are the timestamp formats accepted for deadlines and date filters.
+var timestampLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	time.RFC3339Nano,
+	"01.02.2006",
+}
+
+// record is one row of todos. Zero times stand for NULL.
+type record struct {
+	id        string
+	assignee  string
+	title     string
+	summary   string
+	status    string
+	deadline  time.Time
+	createdAt time.Time
+	updatedAt time.Time
+	deletedAt time.Time
+	version   int64
+
+	timeZone   string
+	recurrence string
+	seriesID   string
+	occurrence int64
+	owner      string
+
+	workspaceID string
+	projectID   string
+	parentID    string
+
+	// progress is not stored but computed by the reads that return the record.
+	progress progress
+}
+
+type taskRepo struct {
+	*taskStore
+
+	// actor is who history records as the author of the changes made through the repository.
+	actor string
+	// visibleTo limits the repository to the tasks of a user when set.
+	visibleTo string
+	// workspace limits the repository to the tasks of a workspace when set.
+	workspace string
+}
+
+// taskStore is the state the repositories WithActor returns share.
+type taskStore struct {
+	mu    sync.RWMutex
+	tasks map[string]record
+	clock func() time.Time
+
+	// outbox holds the events of every change, oldest first; the first delivered of them
+	// have been relayed.
+	outbox    []repo.Event
+	delivered int
+
+	// history holds every change, oldest first.
+	history []historyEntry
+
+	// workspaces holds the rows of workspaces, which todos refer to.
+	workspaces map[string]workspaceRecord
+	// projects holds the rows of projects, which todos refer to.
+	projects map[string]projectRecord
+}
+
+// NewTaskRepo returns an empty in-memory task repository. It is safe for concurrent use
+// and behaves like the postgres one, for tests and local development without a database.
+func NewTaskRepo() *taskRepo {
+	now := utc(time.Now())
+	return &taskRepo{taskStore: &taskStore{
+		tasks:    map[string]record{},
+		clock:    time.Now,
+		projects: map[string]projectRecord{},
+		workspaces: map[string]workspaceRecord{
+			repo.DefaultWorkspaceID: {id: repo.DefaultWorkspaceID, name: "default", createdAt: now, updatedAt: now},
+		},
+	}}
+}
+
+func (r *taskRepo) WithActor(actor string) repo.TaskStorageI {
+	scoped := *r
+	scoped.actor = actor
+	return &scoped
+}
+
+func (r *taskRepo) VisibleTo(user string) repo.TaskStorageI {
+	scoped := *r
+	scoped.visibleTo = user
+	return &scoped
+}
+
+func (r *taskRepo) InWorkspace(id string) repo.TaskStorageI {
+	scoped := *r
+	scoped.workspace = id
+	return &scoped
+}
+
+// SetClock makes the repository read the time from clock, so tests can control
+// created_at, updated_at and deleted_at.
+func (r *taskRepo) SetClock(clock func() time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.clock = clock
+}
+
+func (r *taskRepo) Create(task pb.Task) (pb.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.create(task)
+}
+
+func (r *taskRepo) Get(id string) (pb.Task, error) {
+	id, err := parseID(id)
+	if err != nil {
+		return pb.Task{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rec, ok := r.tasks[id]
+	if !ok || rec.deleted() || !r.sees(rec) {
+		return pb.Task{}, repo.ErrNotFound
+	}
+
+	return r.withProgress(rec).task(), nil
+}
+
+func (r *taskRepo) GetMany(ids []string) ([]pb.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tasks []pb.Task
+	counts := r.progress()
+	for _, id := range ids {
+		id, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		if rec, ok := r.tasks[id]; ok && !rec.deleted() && r.sees(rec) {
+			rec.progress = counts[id]
+			tasks = append(tasks, rec.task())
+		}
+	}
+
+	return tasks, nil
+}
+
+func (r *taskRepo) List(req pb.ListReq) (pb.ListResp, error) {
+	return r.list(false, req)
+}
+
+func (r *taskRepo) ListDeleted(req pb.ListReq) (pb.ListResp, error) {
+	return r.list(true, req)
+}
+
+func (r *taskRepo) list(deleted bool, req pb.ListReq) (pb.ListResp, error) {
+	match, err := listFilter(deleted, req)
+	if err != nil {
+		return pb.ListResp{}, err
+	}
+	if req.ProjectId == "" && !req.IncludeArchived {
+		listed := match
+		match = func(rec record) bool {
+			return listed(rec) && !r.projects[rec.projectID].archived
+		}
+	}
+
+	r.mu.RLock()
+	recs := r.filter(match)
+	r.mu.RUnlock()
+
+	if req.PageToken != "" || req.Page == 0 {
+		if column, _ := sortColumn(req.SortBy); column != "created_at" {
+			return pb.ListResp{}, &repo.FieldError{Field: "sort_by", Description: "only created_at is supported with page tokens"}
+		}
+		desc, err := descending(req.SortOrder)
+		if err != nil {
+			return pb.ListResp{}, err
+		}
+		return listByCursor(recs, req.PageToken, desc, req.Limit)
+	}
+
+	less, err := orderBy(req.SortBy, req.SortOrder)
+	if err != nil {
+		return pb.ListResp{}, err
+	}
+
+	return listByOffset(recs, less, req.Page, req.Limit)
+}
+
+func (r *taskRepo) ListOverdue(req pb.ByDeadlineReq) (pb.ListResp, error) {
+	deadline, err := repo.ParseOverdueCutoff(req.Deadline)
+	if err != nil {
+		return pb.ListResp{}, err
+	}
+
+	r.mu.RLock()
+	recs := r.filter(func(rec record) bool {
+		return !rec.deleted() && !rec.deadline.IsZero() && rec.deadline.Before(deadline)
+	})
+	r.mu.RUnlock()
+
+	if req.PageToken != "" || req.Page == 0 {
+		return listByCursor(recs, req.PageToken, false, req.Limit)
+	}
+
+	return listByOffset(recs, byCreatedAt(false), req.Page, req.Limit)
+}
+
+func (r *taskRepo) Update(task pb.Task) (pb.Task, error) {
+	return r.Patch(task, nil)
+}
+
+func (r *taskRepo) Patch(task pb.Task, fields []string) (pb.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.patch(task, fields)
+}
+
+func (r *taskRepo) ChangeStatus(id, from, to string) (pb.Task, error) {
+	id, err := parseID(id)
+	if err != nil {
+		return pb.Task{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.tasks[id]
+	if !ok || rec.deleted() || !r.sees(rec) {
+		return pb.Task{}, repo.ErrNotFound
+	}
+	if rec.status != from {
+		return pb.Task{}, repo.ErrConflict
+	}
+
+	before := r.withProgress(rec).task()
+	rec.status = to
+	if err := rec.check(); err != nil {
+		return pb.Task{}, err
+	}
+	rec.updatedAt = r.now()
+	rec.version++
+	r.tasks[id] = rec
+
+	task := r.withProgress(rec).task()
+	r.record(repo.ActionUpdate, before, task)
+	r.emit(task, repo.ChangeEvents(from, task)...)
+	return task, nil
+}
+
+func (r *taskRepo) Delete(id string, version int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.delete(id, version)
+}
+
+func (r *taskRepo) Restore(id string) (pb.Task, error) {
+	id, err := parseID(id)
+	if err != nil {
+		return pb.Task{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.tasks[id]
+	if !ok || !rec.deleted() || !r.sees(rec) {
+		return pb.Task{}, repo.ErrNotFound
+	}
+	if parent, ok := r.tasks[rec.parentID]; ok && parent.deleted() {
+		return pb.Task{}, repo.ErrParentDeleted
+	}
+
+	// The subtasks come back first, for the task to roll them up.
+	now, counts := r.now(), r.progress()
+	var restored []pb.Task
+	for _, sub := range r.subtasks(id, rec.deletedAt, true) {
+		sub.deletedAt = time.Time{}
+		sub.updatedAt = now
+		sub.version++
+		r.tasks[sub.id] = sub
+		sub.progress = counts[sub.id]
+		restored = append(restored, sub.task())
+	}
+
+	rec.deletedAt = time.Time{}
+	rec.updatedAt = now
+	rec.version++
+	r.tasks[id] = rec
+
+	task := r.withProgress(rec).task()
+	r.record(repo.ActionRestore, task, task)
+	r.emit(task, repo.EventTaskUpdated)
+	for _, sub := range restored {
+		r.record(repo.ActionRestore, sub, sub)
+		r.emit(sub, repo.EventTaskUpdated)
+	}
+	return task, nil
+}
+
+func (r *taskRepo) Purge(id string) error {
+	id, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.tasks[id]
+	if !ok || !rec.deleted() || !r.sees(rec) {
+		return repo.ErrNotFound
+	}
+	r.purge(id)
+
+	return nil
+}
+
+func (r *taskRepo) PurgeDeletedBefore(t time.Time) (int64, error) {
+	cutoff := utc(t)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []string
+	for id, rec := range r.tasks {
+		if rec.deleted() && rec.deletedAt.Before(cutoff) && r.sees(rec) {
+			matched = append(matched, id)
+		}
+	}
+	var purged int64
+	for _, id := range matched {
+		purged += r.purge(id)
+	}
+
+	return purged, nil
+}
+
+// create, patch and delete check everything before they write, so a failed call
+// leaves the repository as it was, outbox and history included. Callers hold the write lock.
+
+func (r *taskRepo) create(task pb.Task) (pb.Task, error) {
+	id, err := parseID(task.Id)
+	if err != nil {
+		return pb.Task{}, err
+	}
+	if _, ok := r.tasks[id]; ok {
+		return pb.Task{}, fmt.Errorf(`%w: duplicate key value violates unique constraint "todos_pkey"`, repo.ErrConflict)
+	}
+
+	rec := record{
+		id:        id,
+		assignee:  task.Assignee,
+		title:     task.Title,
+		summary:   task.Summary,
+		status:    task.Status,
+		createdAt: r.now(),
+		version:   1,
+
+		timeZone:   task.TimeZone,
+		recurrence: task.Recurrence,
+		occurrence: task.Occurrence,
+		owner:      task.Owner,
+	}
+	if rec.deadline, err = deadline(task); err != nil {
+		return pb.Task{}, err
+	}
+	if rec.seriesID, err = parseSeriesID(task.SeriesId); err != nil {
+		return pb.Task{}, err
+	}
+	if rec.workspaceID, err = r.parseWorkspaceID(r.workspaceOf(task)); err != nil {
+		return pb.Task{}, err
+	}
+	if rec.projectID, err = r.parseProjectID(task.ProjectId, rec.workspaceID); err != nil {
+		return pb.Task{}, err
+	}
+	if rec.parentID, err = r.parseParentID(task.ParentId, rec); err != nil {
+		return pb.Task{}, err
+	}
+	if err := rec.check(); err != nil {
+		return pb.Task{}, err
+	}
+	r.tasks[id] = rec
+
+	created := rec.task()
+	r.record(repo.ActionCreate, pb.Task{}, created)
+	r.emit(created, repo.EventTaskCreated)
+	return created, nil
+}
+
+// patch writes the given fields of task, all of them when fields is nil.
+func (r *taskRepo) patch(task pb.Task, fields []string) (pb.Task, error) {
+	id, err := parseID(task.Id)
+	if err != nil {
+		return pb.Task{}, err
+	}
+	if fields == nil {
+		fields = []string{"assignee", "title", "summary", "deadline", "status", "recurrence", "project_id", "parent_id"}
+	}
+
+	rec, ok := r.tasks[id]
+	before, visible := r.withProgress(rec).task(), r.sees(rec)
+	for _, field := range fields {
+		switch field {
+		case "assignee":
+			rec.assignee = task.Assignee
+		case "title":
+			rec.title = task.Title
+		case "summary":
+			rec.summary = task.Summary
+		case "status":
+			rec.status = task.Status
+		case "deadline":
+			if rec.deadline, err = deadline(task); err != nil {
+				return pb.Task{}, err
+			}
+			rec.timeZone = task.TimeZone
+		case "recurrence":
+			if rec.seriesID, err = parseSeriesID(task.SeriesId); err != nil {
+				return pb.Task{}, err
+			}
+			rec.recurrence = task.Recurrence
+			rec.occurrence = task.Occurrence
+		case "project_id":
+			// a missing task is not found rather than pointing at a project of no workspace
+			if !ok {
+				continue
+			}
+			if rec.projectID, err = r.parseProjectID(task.ProjectId, rec.workspaceID); err != nil {
+				return pb.Task{}, err
+			}
+		case "parent_id":
+			// like project_id, and a task that is not found can't be a cycle either
+			if !ok || rec.deleted() || !visible {
+				continue
+			}
+			if rec.parentID, err = r.parseParentID(task.ParentId, rec); err != nil {
+				return pb.Task{}, err
+			}
+		default:
+			return pb.Task{}, &repo.FieldError{Field: "update_mask", Description: fmt.Sprintf("unknown task field %q", field)}
+		}
+	}
+
+	if !ok || rec.deleted() || !visible {
+		return pb.Task{}, repo.ErrNotFound
+	}
+	if task.Version != 0 && task.Version != rec.version {
+		return pb.Task{}, repo.ErrConflict
+	}
+	if err := rec.check(); err != nil {
+		return pb.Task{}, err
+	}
+
+	rec.updatedAt = r.now()
+	rec.version++
+	r.tasks[id] = rec
+
+	updated := r.withProgress(rec).task()
+	r.record(repo.ActionUpdate, before, updated)
+	r.emit(updated, repo.ChangeEvents(before.Status, updated)...)
+	return updated, nil
+}
+
+func (r *taskRepo) delete(id string, version int64) error {
+	id, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	rec, ok := r.tasks[id]
+	if !ok || rec.deleted() || !r.sees(rec) {
+		return repo.ErrNotFound
+	}
+	if version != 0 && version != rec.version {
+		return repo.ErrConflict
+	}
+
+	now, counts := r.now(), r.progress()
+	rec.deletedAt = now
+	rec.version++
+	r.tasks[id] = rec
+
+	rec.progress = counts[id]
+	deleted := rec.task()
+	r.record(repo.ActionDelete, deleted, deleted)
+	r.emit(deleted, repo.EventTaskDeleted)
+
+	for _, sub := range r.subtasks(id, time.Time{}, true) {
+		sub.deletedAt = now
+		sub.version++
+		r.tasks[sub.id] = sub
+
+		sub.progress = counts[sub.id]
+		deleted := sub.task()
+		r.record(repo.ActionDelete, deleted, deleted)
+		r.emit(deleted, repo.EventTaskDeleted)
+	}
+	return nil
+}
+
+// emit adds the events of types about task to the outbox. Callers hold the write lock.
+func (r *taskRepo) emit(task pb.Task, types ...repo.EventType) {
+	for _, t := range types {
+		r.outbox = append(r.outbox, repo.Event{
+			Seq:        int64(len(r.outbox) + 1),
+			ID:         uuid.Must(uuid.NewV4()).String(),
+			Type:       t,
+			Task:       task,
+			OccurredAt: r.now(),
+		})
+	}
+}
+
+// filter returns the records match accepts among those the repository sees. Callers hold
+// the read lock.
+func (r *taskRepo) filter(match func(record) bool) []record {
+	var recs []record
+	counts := r.progress()
+	for _, rec := range r.tasks {
+		if match(rec) && r.sees(rec) {
+			rec.progress = counts[rec.id]
+			recs = append(recs, rec)
+		}
+	}
+
+	return recs
+}
+
+// sees tells whether rec is among the tasks the repository is limited to.
+func (r *taskRepo) sees(rec record) bool {
+	return (r.workspace == "" || rec.workspaceID == r.workspace) &&
+		(r.visibleTo == "" || rec.owner == r.visibleTo || rec.assignee == r.visibleTo)
+}
+
+// limited tells whether the repository sees only some of the tasks.
+func (r *taskRepo) limited() bool {
+	return r.visibleTo != "" || r.workspace != ""
+}
+
+// workspaceOf is the workspace task is created in: the one of the repository, else the
+// one task names, else the default one.
+func (r *taskRepo) workspaceOf(task pb.Task) string {
+	switch {
+	case r.workspace != "":
+		return r.workspace
+	case task.WorkspaceId != "":
+		return task.WorkspaceId
+	}
+	return repo.DefaultWorkspaceID
+}
+
+func (rec record) deleted() bool {
+	return !rec.deletedAt.IsZero()
+}
+
+// check enforces the column sizes and the status check constraint of todos.
+func (rec record) check() error {
+	values := map[string]string{
+		"assignee":   rec.assignee,
+		"title":      rec.title,
+		"summary":    rec.summary,
+		"time_zone":  rec.timeZone,
+		"recurrence": rec.recurrence,
+		"owner":      rec.owner,
+	}
+	for field, value := range values {
+		if utf8.RuneCountInString(value) > maxLen[field] {
+			return &repo.FieldError{Field: field, Description: fmt.Sprintf("value too long for type character varying(%d)", maxLen[field])}
+		}
+	}
+
+	if !statuses[rec.status] {
+		return &repo.FieldError{Field: "status", Description: `new row for relation "todos" violates check constraint "todos_status_check"`}
+	}
+
+	return nil
+}
+
+// task is the record the way Get returns it.
+func (rec record) task() pb.Task {
+	task := pb.Task{
+		Id:       rec.id,
+		Assignee: rec.assignee,
+		Title:    rec.title,
+		Summary:  rec.summary,
+		Status:   rec.status,
+		Version:  rec.version,
+
+		TimeZone:   rec.timeZone,
+		Recurrence: rec.recurrence,
+		SeriesId:   rec.seriesID,
+		Occurrence: rec.occurrence,
+		Owner:      rec.owner,
+
+		WorkspaceId:   rec.workspaceID,
+		ProjectId:     rec.projectID,
+		ParentId:      rec.parentID,
+		SubtasksTotal: rec.progress.total,
+		SubtasksDone:  rec.progress.done,
+	}
+	task.Deadline, task.DeadlineTime = timestamp(rec.deadline)
+	task.CreatedAt, task.CreatedTime = timestamp(rec.createdAt)
+	task.UpdatedAt, task.UpdatedTime = timestamp(rec.updatedAt)
+	return task
+}
+
+// listed is the record the way List returns it: with deleted_at and without updated_at.
+func (rec record) listed() *pb.Task {
+	task := rec.task()
+	task.UpdatedAt, task.UpdatedTime = "", nil
+	task.DeletedAt, task.DeletedTime = timestamp(rec.deletedAt)
+	return &task
+}
+
+// parseID returns id in canonical form, the way a uuid column stores it.
+func parseID(id string) (string, error) {
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return "", &repo.FieldError{Field: "id", Description: fmt.Sprintf("invalid input syntax for type uuid: %q", id)}
+	}
+
+	return parsed.String(), nil
+}
+
+// parseSeriesID is parseID for the nullable series_id column.
+func parseSeriesID(id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return "", &repo.FieldError{Field: "series_id", Description: fmt.Sprintf("invalid input syntax for type uuid: %q", id)}
+	}
+	return parsed.String(), nil
+}
+
+// parseWorkspaceID returns id in canonical form, checking it refers to a workspace the
+// way the todos_workspace_id_fkey foreign key does.
+func (r *taskRepo) parseWorkspaceID(id string) (string, error) {
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return "", &repo.FieldError{Field: "workspace_id", Description: fmt.Sprintf("invalid input syntax for type uuid: %q", id)}
+	}
+	if _, ok := r.workspaces[parsed.String()]; !ok {
+		return "", &repo.FieldError{Field: "workspace_id", Description: `insert or update on table "todos" violates foreign key constraint "todos_workspace_id_fkey"`}
+	}
+	return parsed.String(), nil
+}
+
+// parseProjectID returns id in canonical form, checking it refers to a project of workspace
+// the way the todos_project_fkey foreign key does. An empty id stands for NULL.
+func (r *taskRepo) parseProjectID(id, workspace string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return "", &repo.FieldError{Field: "project_id", Description: fmt.Sprintf("invalid input syntax for type uuid: %q", id)}
+	}
+	if project, ok := r.projects[parsed.String()]; !ok || project.workspaceID != workspace {
+		return "", &repo.FieldError{Field: "project_id", Description: `insert or update on table "todos" violates foreign key constraint "todos_project_fkey"`}
+	}
+	return parsed.String(), nil
+}
+
+// parseTimestamp parses value like a timestamp column does, returning the zero time for "".
+func parseTimestamp(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return utc(t), nil
+		}
+	}
+
+	return time.Time{}, &repo.FieldError{Field: field, Description: fmt.Sprintf("invalid input syntax for type timestamp: %q", value)}
+}
+
+// deadline is the deadline to store for task: deadline_time when it is set, otherwise
+// the Deadline string.
+func deadline(task pb.Task) (time.Time, error) {
+	if task.DeadlineTime != nil {
+		if t, err := types.TimestampFromProto(task.DeadlineTime); err == nil {
+			return utc(t), nil
+		}
+	}
+
+	return parseTimestamp("deadline", task.Deadline)
+}
+
+// timestamp renders t both ways a task carries its times.
+func timestamp(t time.Time) (string, *types.Timestamp) {
+	if t.IsZero() {
+		return "", nil
+	}
+
+	ts, err := types.TimestampProto(t)
+	if err != nil {
+		return t.Format(time.RFC3339Nano), nil
+	}
+
+	return t.Format(time.RFC3339Nano), ts
+}
+
+// utc normalizes t the way a timestamptz column stores it: as an instant, to the microsecond.
+func utc(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
+
+// now reads the clock. Callers hold the lock.
+func (r *taskRepo) now() time.Time {
+	return utc(r.clock())
+}
+
+func sortRecords(recs []record, less func(a, b record) bool) {
+	sort.Slice(recs, func(i, j int) bool {
+		return less(recs[i], recs[j])
+	})
+}
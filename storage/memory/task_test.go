@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/storagetest"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTaskRepoConformance(t *testing.T) {
+	suite.Run(t, &storagetest.TaskStorageSuite{Repository: NewTaskRepo()})
+}
+
+func TestReminderRepoConformance(t *testing.T) {
+	tasks := NewTaskRepo()
+	suite.Run(t, &storagetest.ReminderStorageSuite{Tasks: tasks, Reminders: NewReminderRepo(tasks)})
+}
+
+func TestWebhookRepoConformance(t *testing.T) {
+	suite.Run(t, &storagetest.WebhookStorageSuite{Repository: NewWebhookRepo()})
+}
+
+func TestWorkspaceRepoConformance(t *testing.T) {
+	tasks := NewTaskRepo()
+	suite.Run(t, &storagetest.WorkspaceStorageSuite{Workspaces: NewWorkspaceRepo(tasks), Tasks: tasks})
+}
+
+func TestProjectRepoConformance(t *testing.T) {
+	tasks := NewTaskRepo()
+	suite.Run(t, &storagetest.ProjectStorageSuite{Projects: NewProjectRepo(tasks), Workspaces: NewWorkspaceRepo(tasks), Tasks: tasks})
+}
+
+func TestOutboxRepoConformance(t *testing.T) {
+	tasks := NewTaskRepo()
+	suite.Run(t, &storagetest.OutboxStorageSuite{Tasks: tasks, Outbox: NewOutboxRepo(tasks)})
+}
+
+func TestTaskRepo_PurgeDeletedBefore(t *testing.T) {
+	r := NewTaskRepo()
+	task, err := r.Create(pb.Task{Id: "9f0c2b1e-3a4d-4e5f-8a6b-7c8d9e0f1a2b", Title: "Old", Status: "todo"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err = r.Delete(task.Id, 0); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		cutoff time.Time
+		want   int64
+	}{
+		{name: "before the delete", cutoff: time.Now().Add(-time.Hour), want: 0},
+		{name: "after the delete", cutoff: time.Now().Add(time.Hour), want: 1},
+		{name: "already purged", cutoff: time.Now().Add(time.Hour), want: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := r.PurgeDeletedBefore(tc.cutoff)
+			if err != nil || got != tc.want {
+				t.Fatalf("%s: expected: %d, got: %d, %v", tc.name, tc.want, got, err)
+			}
+		})
+	}
+}
+
+func TestTaskRepo_Concurrent(t *testing.T) {
+	r := NewTaskRepo()
+	id := uuid.Must(uuid.NewV4()).String()
+	if _, err := r.Create(pb.Task{Id: id, Title: "Shared", Status: "todo"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	const workers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _ = r.Patch(pb.Task{Id: id, Title: fmt.Sprintf("Worker %d", i)}, []string{"title"})
+			_, _ = r.List(pb.ListReq{Page: 1, Limit: 10})
+			_, _ = r.Create(pb.Task{Id: uuid.Must(uuid.NewV4()).String(), Title: "Own", Status: "todo"})
+		}(i)
+	}
+	wg.Wait()
+
+	task, err := r.Get(id)
+	if err != nil || task.Version != workers+1 {
+		t.Fatalf("concurrent patches: expected version %d, got: %v, %v", workers+1, task, err)
+	}
+	list, err := r.List(pb.ListReq{Page: 1, Limit: 1})
+	if err != nil || list.Count != workers+1 {
+		t.Fatalf("concurrent creates: expected %d tasks, got: %v, %v", workers+1, list.Count, err)
+	}
+}
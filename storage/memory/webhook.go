@@ -0,0 +1,349 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// Column sizes of the webhooks tables, see migrations/.
+var webhookMaxLen = map[string]int{
+	"url":    2048,
+	"secret": 128,
+	"event":  50,
+}
+
+// webhookRecord is one row of webhooks with the rows that reference it.
+type webhookRecord struct {
+	hook        pb.Webhook
+	createdAt   time.Time
+	updatedAt   time.Time
+	deliveries  []deliveryRecord
+	deadLetters []repo.DeadLetter
+}
+
+type deliveryRecord struct {
+	delivery  pb.WebhookDelivery
+	createdAt time.Time
+}
+
+type webhookRepo struct {
+	mu    sync.RWMutex
+	hooks map[string]*webhookRecord
+	clock func() time.Time
+}
+
+// NewWebhookRepo returns an empty in-memory webhook repository.
+func NewWebhookRepo() *webhookRepo {
+	return &webhookRepo{hooks: map[string]*webhookRecord{}, clock: time.Now}
+}
+
+func (r *webhookRepo) Create(hook pb.Webhook) (pb.Webhook, error) {
+	id, err := parseID(hook.Id)
+	if err != nil {
+		return pb.Webhook{}, err
+	}
+	if err := checkWebhook(hook); err != nil {
+		return pb.Webhook{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.hooks[id]; ok {
+		return pb.Webhook{}, fmt.Errorf(`%w: duplicate key value violates unique constraint "webhooks_pkey"`, repo.ErrConflict)
+	}
+
+	now := utc(r.clock())
+	rec := &webhookRecord{createdAt: now, updatedAt: now}
+	rec.hook = pb.Webhook{
+		Id:       id,
+		Url:      hook.Url,
+		Events:   append([]string(nil), hook.Events...),
+		Secret:   hook.Secret,
+		Disabled: hook.Disabled,
+	}
+	r.hooks[id] = rec
+
+	return rec.webhook(), nil
+}
+
+func (r *webhookRepo) Get(id string) (pb.Webhook, error) {
+	id, err := parseID(id)
+	if err != nil {
+		return pb.Webhook{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rec, ok := r.hooks[id]
+	if !ok {
+		return pb.Webhook{}, repo.ErrNotFound
+	}
+
+	return rec.webhook(), nil
+}
+
+func (r *webhookRepo) List(req pb.ListWebhooksReq) (pb.ListWebhooksResp, error) {
+	if err := checkPage(req.Page, req.Limit); err != nil {
+		return pb.ListWebhooksResp{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	recs := r.sorted()
+	start, end := pageBounds(len(recs), req.Page, req.Limit)
+	resp := pb.ListWebhooksResp{Count: int64(len(recs))}
+	for _, rec := range recs[start:end] {
+		hook := rec.webhook()
+		resp.Webhooks = append(resp.Webhooks, &hook)
+	}
+
+	return resp, nil
+}
+
+func (r *webhookRepo) Update(hook pb.Webhook) (pb.Webhook, error) {
+	id, err := parseID(hook.Id)
+	if err != nil {
+		return pb.Webhook{}, err
+	}
+	if err := checkWebhook(hook); err != nil {
+		return pb.Webhook{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.hooks[id]
+	if !ok {
+		return pb.Webhook{}, repo.ErrNotFound
+	}
+
+	rec.hook.Url = hook.Url
+	rec.hook.Events = append([]string(nil), hook.Events...)
+	rec.hook.Disabled = hook.Disabled
+	if hook.Secret != "" {
+		rec.hook.Secret = hook.Secret
+	}
+	rec.updatedAt = utc(r.clock())
+
+	return rec.webhook(), nil
+}
+
+func (r *webhookRepo) Delete(id string) error {
+	id, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.hooks[id]; !ok {
+		return repo.ErrNotFound
+	}
+	delete(r.hooks, id)
+
+	return nil
+}
+
+func (r *webhookRepo) Subscribers(event string) ([]pb.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var hooks []pb.Webhook
+	for _, rec := range r.sorted() {
+		if !rec.hook.Disabled && hasString(rec.hook.Events, event) {
+			hooks = append(hooks, rec.webhook())
+		}
+	}
+
+	return hooks, nil
+}
+
+func (r *webhookRepo) RecordDelivery(d pb.WebhookDelivery) error {
+	id, err := parseID(d.Id)
+	if err != nil {
+		return err
+	}
+	if err := checkLen("event", d.Event); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, err := r.referenced(d.WebhookId, "webhook_deliveries")
+	if err != nil {
+		return err
+	}
+	for _, existing := range rec.deliveries {
+		if existing.delivery.Id == id {
+			return fmt.Errorf(`%w: duplicate key value violates unique constraint "webhook_deliveries_pkey"`, repo.ErrConflict)
+		}
+	}
+
+	d.Id, d.WebhookId, d.CreatedTime = id, rec.hook.Id, nil
+	rec.deliveries = append(rec.deliveries, deliveryRecord{delivery: d, createdAt: utc(r.clock())})
+
+	return nil
+}
+
+func (r *webhookRepo) ListDeliveries(req pb.ListWebhookDeliveriesReq) (pb.ListWebhookDeliveriesResp, error) {
+	id, err := parseID(req.WebhookId)
+	if err != nil {
+		return pb.ListWebhookDeliveriesResp{}, err
+	}
+	if err := checkPage(req.Page, req.Limit); err != nil {
+		return pb.ListWebhookDeliveriesResp{}, err
+	}
+
+	r.mu.RLock()
+	var recs []deliveryRecord
+	if rec, ok := r.hooks[id]; ok {
+		recs = append(recs, rec.deliveries...)
+	}
+	r.mu.RUnlock()
+
+	sort.SliceStable(recs, func(i, j int) bool {
+		a, b := recs[i], recs[j]
+		if c := compareTimes(a.createdAt, b.createdAt); c != 0 {
+			return c > 0
+		}
+		if a.delivery.Attempt != b.delivery.Attempt {
+			return a.delivery.Attempt > b.delivery.Attempt
+		}
+		return a.delivery.Id < b.delivery.Id
+	})
+
+	start, end := pageBounds(len(recs), req.Page, req.Limit)
+	resp := pb.ListWebhookDeliveriesResp{Count: int64(len(recs))}
+	for _, rec := range recs[start:end] {
+		d := rec.delivery
+		_, d.CreatedTime = timestamp(rec.createdAt)
+		resp.Deliveries = append(resp.Deliveries, &d)
+	}
+
+	return resp, nil
+}
+
+func (r *webhookRepo) AddDeadLetter(d repo.DeadLetter) error {
+	id, err := parseID(d.ID)
+	if err != nil {
+		return err
+	}
+	if err := checkLen("event", d.Event); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, err := r.referenced(d.WebhookID, "webhook_dead_letters")
+	if err != nil {
+		return err
+	}
+
+	d.ID, d.WebhookID, d.CreatedAt = id, rec.hook.Id, utc(r.clock())
+	d.Payload = append([]byte(nil), d.Payload...)
+	rec.deadLetters = append(rec.deadLetters, d)
+
+	return nil
+}
+
+func (r *webhookRepo) ListDeadLetters(webhookID string) ([]repo.DeadLetter, error) {
+	id, err := parseID(webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rec, ok := r.hooks[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return append([]repo.DeadLetter(nil), rec.deadLetters...), nil
+}
+
+// referenced returns the webhook a row of table points to, failing like its foreign key.
+// Callers hold the lock.
+func (r *webhookRepo) referenced(webhookID, table string) (*webhookRecord, error) {
+	id, err := parseID(webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	rec, ok := r.hooks[id]
+	if !ok {
+		return nil, &repo.FieldError{Field: "webhook_id", Description: fmt.Sprintf(
+			`insert or update on table %q violates foreign key constraint "%s_webhook_id_fkey"`, table, table)}
+	}
+
+	return rec, nil
+}
+
+// sorted returns the webhooks oldest first. Callers hold the lock.
+func (r *webhookRepo) sorted() []*webhookRecord {
+	recs := make([]*webhookRecord, 0, len(r.hooks))
+	for _, rec := range r.hooks {
+		recs = append(recs, rec)
+	}
+	sort.Slice(recs, func(i, j int) bool {
+		if c := compareTimes(recs[i].createdAt, recs[j].createdAt); c != 0 {
+			return c < 0
+		}
+		return recs[i].hook.Id < recs[j].hook.Id
+	})
+
+	return recs
+}
+
+func (rec *webhookRecord) webhook() pb.Webhook {
+	hook := rec.hook
+	hook.Events = append([]string(nil), rec.hook.Events...)
+	_, hook.CreatedTime = timestamp(rec.createdAt)
+	_, hook.UpdatedTime = timestamp(rec.updatedAt)
+	return hook
+}
+
+func checkWebhook(hook pb.Webhook) error {
+	if err := checkLen("url", hook.Url); err != nil {
+		return err
+	}
+	if err := checkLen("secret", hook.Secret); err != nil {
+		return err
+	}
+	if hook.Events == nil {
+		return &repo.FieldError{Field: "events", Description: `null value in column "events" violates not-null constraint`}
+	}
+
+	return nil
+}
+
+// checkLen rejects value when it is longer than its webhooks column allows.
+func checkLen(column, value string) error {
+	if n := webhookMaxLen[column]; utf8.RuneCountInString(value) > n {
+		return &repo.FieldError{Field: column, Description: fmt.Sprintf("value too long for type character varying(%d)", n)}
+	}
+
+	return nil
+}
+
+func hasString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// maxWorkspaceName is the size of the workspaces.name column, see migrations/.
+const maxWorkspaceName = 100
+
+// workspaceRecord is one row of workspaces.
+type workspaceRecord struct {
+	id        string
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// workspaceRepo keeps its workspaces with the tasks of tasks, so that tasks can only be
+// created in workspaces that exist and workspaces with projects or tasks can't be deleted.
+type workspaceRepo struct {
+	tasks *taskRepo
+}
+
+// NewWorkspaceRepo returns an in-memory workspace repository for the tasks of tasks,
+// holding the default workspace.
+func NewWorkspaceRepo(tasks *taskRepo) *workspaceRepo {
+	return &workspaceRepo{tasks: tasks}
+}
+
+func (r *workspaceRepo) Create(workspace pb.Workspace) (pb.Workspace, error) {
+	id, err := parseID(workspace.Id)
+	if err != nil {
+		return pb.Workspace{}, err
+	}
+	if err := checkWorkspaceName(workspace.Name); err != nil {
+		return pb.Workspace{}, err
+	}
+
+	r.tasks.mu.Lock()
+	defer r.tasks.mu.Unlock()
+
+	if _, ok := r.tasks.workspaces[id]; ok {
+		return pb.Workspace{}, fmt.Errorf(`%w: duplicate key value violates unique constraint "workspaces_pkey"`, repo.ErrConflict)
+	}
+	if err := r.checkNameFree(id, workspace.Name); err != nil {
+		return pb.Workspace{}, err
+	}
+
+	now := utc(r.tasks.now())
+	rec := workspaceRecord{id: id, name: workspace.Name, createdAt: now, updatedAt: now}
+	r.tasks.workspaces[id] = rec
+
+	return rec.workspace(), nil
+}
+
+func (r *workspaceRepo) Get(id string) (pb.Workspace, error) {
+	id, err := parseID(id)
+	if err != nil {
+		return pb.Workspace{}, err
+	}
+
+	r.tasks.mu.RLock()
+	defer r.tasks.mu.RUnlock()
+
+	rec, ok := r.tasks.workspaces[id]
+	if !ok {
+		return pb.Workspace{}, repo.ErrNotFound
+	}
+
+	return rec.workspace(), nil
+}
+
+func (r *workspaceRepo) List(req pb.ListWorkspacesReq) (pb.ListWorkspacesResp, error) {
+	if err := checkPage(req.Page, req.Limit); err != nil {
+		return pb.ListWorkspacesResp{}, err
+	}
+
+	r.tasks.mu.RLock()
+	defer r.tasks.mu.RUnlock()
+
+	recs := make([]workspaceRecord, 0, len(r.tasks.workspaces))
+	for _, rec := range r.tasks.workspaces {
+		recs = append(recs, rec)
+	}
+	sort.Slice(recs, func(i, j int) bool {
+		if c := compareTimes(recs[i].createdAt, recs[j].createdAt); c != 0 {
+			return c < 0
+		}
+		return recs[i].id < recs[j].id
+	})
+
+	start, end := pageBounds(len(recs), req.Page, req.Limit)
+	resp := pb.ListWorkspacesResp{Count: int64(len(recs))}
+	for _, rec := range recs[start:end] {
+		workspace := rec.workspace()
+		resp.Workspaces = append(resp.Workspaces, &workspace)
+	}
+
+	return resp, nil
+}
+
+func (r *workspaceRepo) Update(workspace pb.Workspace) (pb.Workspace, error) {
+	id, err := parseID(workspace.Id)
+	if err != nil {
+		return pb.Workspace{}, err
+	}
+	if err := checkWorkspaceName(workspace.Name); err != nil {
+		return pb.Workspace{}, err
+	}
+
+	r.tasks.mu.Lock()
+	defer r.tasks.mu.Unlock()
+
+	rec, ok := r.tasks.workspaces[id]
+	if !ok {
+		return pb.Workspace{}, repo.ErrNotFound
+	}
+	if err := r.checkNameFree(id, workspace.Name); err != nil {
+		return pb.Workspace{}, err
+	}
+
+	rec.name = workspace.Name
+	rec.updatedAt = utc(r.tasks.now())
+	r.tasks.workspaces[id] = rec
+
+	return rec.workspace(), nil
+}
+
+func (r *workspaceRepo) Delete(id string) error {
+	id, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	r.tasks.mu.Lock()
+	defer r.tasks.mu.Unlock()
+
+	if _, ok := r.tasks.workspaces[id]; !ok {
+		return repo.ErrNotFound
+	}
+	for _, rec := range r.tasks.tasks {
+		if rec.workspaceID == id {
+			return repo.ErrInUse
+		}
+	}
+	for _, rec := range r.tasks.projects {
+		if rec.workspaceID == id {
+			return repo.ErrInUse
+		}
+	}
+	delete(r.tasks.workspaces, id)
+
+	return nil
+}
+
+// checkNameFree enforces the unique constraint on workspaces.name. Callers hold the lock.
+func (r *workspaceRepo) checkNameFree(id, name string) error {
+	for _, rec := range r.tasks.workspaces {
+		if rec.name == name && rec.id != id {
+			return fmt.Errorf(`%w: duplicate key value violates unique constraint "workspaces_name_key"`, repo.ErrConflict)
+		}
+	}
+
+	return nil
+}
+
+func checkWorkspaceName(name string) error {
+	if utf8.RuneCountInString(name) > maxWorkspaceName {
+		return &repo.FieldError{Field: "name", Description: fmt.Sprintf("value too long for type character varying(%d)", maxWorkspaceName)}
+	}
+
+	return nil
+}
+
+func (rec workspaceRecord) workspace() pb.Workspace {
+	workspace := pb.Workspace{Id: rec.id, Name: rec.name}
+	_, workspace.CreatedTime = timestamp(rec.createdAt)
+	_, workspace.UpdatedTime = timestamp(rec.updatedAt)
+	return workspace
+}
@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// maxInsertRows keeps a multi-row INSERT well below the 65535 bind parameters Postgres allows.
+const maxInsertRows = 1000
+
+// insertColumns is the number of columns insertTasks writes per row.
+const insertColumns = 15
+
+// GetMany returns the live tasks among ids, in no particular order.
+func (r *taskRepo) GetMany(ids []string) ([]pb.Task, error) {
+	where := r.where(liveTasks)
+	where.add("id = ANY($%d)", pq.Array(ids))
+	rows, err := r.db.Queryx(`SELECT `+taskColumns+` FROM todos `+where.String(), where.args...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var tasks []pb.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, wrapError(err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, wrapError(rows.Err())
+}
+
+func (r *taskRepo) BatchCreate(tasks []pb.Task, atomic bool) ([]repo.BatchResult, error) {
+	if !atomic {
+		return r.eachInTx(len(tasks), func(q querier, i int) (pb.Task, error) {
+			return r.insertTask(q, tasks[i])
+		})
+	}
+
+	results := make([]repo.BatchResult, len(tasks))
+	err := r.inTx(func(tx *sqlx.Tx) error {
+		for start := 0; start < len(tasks); start += maxInsertRows {
+			end := start + maxInsertRows
+			if end > len(tasks) {
+				end = len(tasks)
+			}
+			if _, err := tx.Exec(`SAVEPOINT batch_chunk`); err != nil {
+				return err
+			}
+			if err := r.insertTasks(tx, tasks[start:end], results[start:end]); err != nil {
+				return r.findFailedInsert(tx, tasks, start, end, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return results, nil
+}
+
+func (r *taskRepo) BatchUpdate(patches []repo.TaskPatch, atomic bool) ([]repo.BatchResult, error) {
+	update := func(q querier, i int) (pb.Task, error) {
+		fields := patches[i].Fields
+		if fields == nil {
+			fields = taskFields
+		}
+		return r.patchTask(q, patches[i].Task, fields)
+	}
+
+	if !atomic {
+		return r.eachInTx(len(patches), update)
+	}
+	return r.allInTx(len(patches), update)
+}
+
+func (r *taskRepo) BatchDelete(items []pb.ByIdReq, atomic bool) ([]repo.BatchResult, error) {
+	remove := func(q querier, i int) (pb.Task, error) {
+		return pb.Task{Id: items[i].Id}, r.deleteTask(q, items[i].Id, items[i].Version)
+	}
+
+	if !atomic {
+		return r.eachInTx(len(items), remove)
+	}
+	return r.allInTx(len(items), remove)
+}
+
+// insertTasks writes tasks with a single multi-row INSERT and stores the inserted rows in results,
+// which must be as long as tasks.
+func (r *taskRepo) insertTasks(q querier, tasks []pb.Task, results []repo.BatchResult) error {
+	var (
+		values []string
+		args   []interface{}
+	)
+	position := make(map[string]int, len(tasks))
+	now := r.now()
+	for i, task := range tasks {
+		// A parent created earlier in the batch only exists once the INSERT is done, and
+		// can't be a subtask of the new task anyway.
+		if _, earlier := position[task.ParentId]; task.ParentId != "" && !earlier {
+			if err := r.checkParent(q, task.Id, task.ParentId); err != nil {
+				return err
+			}
+		}
+		position[task.Id] = i
+		args = append(args, task.Id, task.Assignee, task.Title, task.Summary, deadlineValue(task), task.Status, now,
+			task.TimeZone, task.Recurrence, nullable(task.SeriesId), task.Occurrence, task.Owner, r.workspaceOf(task), nullable(task.ProjectId),
+			nullable(task.ParentId))
+		placeholders := make([]string, insertColumns)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", len(args)-insertColumns+j+1)
+		}
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+	}
+
+	rows, err := q.Queryx(`INSERT INTO todos(id, assignee, title, summary, deadline, status, created_at, time_zone, recurrence, series_id, occurrence, owner, workspace_id, project_id, parent_id)
+		VALUES `+strings.Join(values, ", ")+` RETURNING `+taskColumns, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close() // nolint:errcheck
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return err
+		}
+		results[position[task.Id]].Task = task
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	entries := make([]historyEntry, len(results))
+	events := make([]repo.Event, len(results))
+	for i, result := range results {
+		entries[i] = historyEntry{action: repo.ActionCreate, after: result.Task}
+		events[i] = repo.Event{Type: repo.EventTaskCreated, Task: result.Task}
+	}
+	if err := writeHistory(q, r.actor, now, entries...); err != nil {
+		return err
+	}
+	return writeEvents(q, now, events)
+}
+
+// findFailedInsert replays the chunk tasks[start:end] whose multi-row INSERT failed with err
+// one row at a time, to tell the client which task it was.
+func (r *taskRepo) findFailedInsert(tx *sqlx.Tx, tasks []pb.Task, start, end int, err error) error {
+	if _, rollbackErr := tx.Exec(`ROLLBACK TO SAVEPOINT batch_chunk`); rollbackErr != nil {
+		return err
+	}
+
+	for i := start; i < end; i++ {
+		if _, insertErr := r.insertTask(tx, tasks[i]); insertErr != nil {
+			return &repo.BatchItemError{Index: i, Err: wrapError(insertErr)}
+		}
+	}
+
+	return err
+}
+
+// allInTx runs fn for every item in one transaction and rolls all of it back on the first failure.
+func (r *taskRepo) allInTx(n int, fn func(q querier, i int) (pb.Task, error)) ([]repo.BatchResult, error) {
+	results := make([]repo.BatchResult, n)
+	err := r.inTx(func(tx *sqlx.Tx) error {
+		for i := 0; i < n; i++ {
+			task, err := fn(tx, i)
+			if err != nil {
+				return &repo.BatchItemError{Index: i, Err: wrapError(err)}
+			}
+			results[i].Task = task
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return results, nil
+}
+
+// eachInTx runs fn for every item in one transaction, isolating the items with a savepoint
+// so a failed one is undone on its own and reported in its result.
+func (r *taskRepo) eachInTx(n int, fn func(q querier, i int) (pb.Task, error)) ([]repo.BatchResult, error) {
+	results := make([]repo.BatchResult, n)
+	err := r.inTx(func(tx *sqlx.Tx) error {
+		for i := 0; i < n; i++ {
+			if _, err := tx.Exec(`SAVEPOINT batch_item`); err != nil {
+				return err
+			}
+
+			task, err := fn(tx, i)
+			if err != nil {
+				results[i].Err = wrapError(err)
+				if _, err := tx.Exec(`ROLLBACK TO SAVEPOINT batch_item`); err != nil {
+					return err
+				}
+				continue
+			}
+
+			results[i].Task = task
+			if _, err := tx.Exec(`RELEASE SAVEPOINT batch_item`); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return results, nil
+}
+
+func (r *taskRepo) inTx(fn func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/lib/pq"
+)
+
+// constraintFields names the field behind each check and foreign key constraint.
+var constraintFields = map[string]string{
+	"todos_status_check":                   "status",
+	"webhook_deliveries_webhook_id_fkey":   "webhook_id",
+	"webhook_dead_letters_webhook_id_fkey": "webhook_id",
+	"todos_workspace_id_fkey":              "workspace_id",
+	"todos_project_fkey":                   "project_id",
+	"projects_workspace_id_fkey":           "workspace_id",
+	"todos_parent_fkey":                    "parent_id",
+}
+
+// wrapError translates database/sql and lib/pq errors into the repo error vocabulary.
+// Errors that are already repo errors, and ones it doesn't recognize, are returned as is.
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return repo.ErrNotFound
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Class() {
+		case "22": // data exception: bad uuid, unparsable date, value too long
+			return &repo.FieldError{Field: pqErr.Column, Description: pqErr.Message}
+		case "23": // integrity constraint violation
+			if pqErr.Code.Name() == "unique_violation" {
+				return fmt.Errorf("%w: %s", repo.ErrConflict, pqErr.Message)
+			}
+			field := pqErr.Column
+			if field == "" {
+				field = constraintFields[pqErr.Constraint]
+			}
+			return &repo.FieldError{Field: field, Description: pqErr.Message}
+		case "08", "53", "57": // connection exception, insufficient resources, operator intervention
+			return fmt.Errorf("%w: %s", repo.ErrUnavailable, pqErr.Message)
+		}
+		return err
+	}
+
+	var netErr net.Error
+	if errors.Is(err, driver.ErrBadConn) || errors.As(err, &netErr) {
+		return fmt.Errorf("%w: %v", repo.ErrUnavailable, err)
+	}
+
+	return err
+}
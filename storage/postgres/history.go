@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// historyColumns is the number of columns writeHistory writes per row.
+const historyColumns = 9
+
+// historyEntry is one change of a task for writeHistory to record.
+type historyEntry struct {
+	action string
+	before pb.Task
+	after  pb.Task
+}
+
+func (r *taskRepo) History(req pb.TaskHistoryReq) (pb.TaskHistoryResp, error) {
+	where := newWhereBuilder("true")
+	where.add("task_id = $%d", req.TaskId)
+	// A limited repository finds no history for the tasks it may not see. The task as of
+	// its latest change decides, so the history outlives a purge.
+	if limits := r.limits(where); len(limits) > 0 {
+		where.addRaw(`EXISTS (SELECT 1 FROM (SELECT workspace_id, owner, assignee FROM task_history changes
+			WHERE changes.task_id = task_history.task_id ORDER BY changes.id DESC LIMIT 1) latest WHERE ` + strings.Join(limits, " and ") + ")")
+	}
+	countArgs := where.args
+
+	rows, err := r.db.Queryx(fmt.Sprintf(`SELECT id, task_id, action, actor, fields, version, changed_at FROM task_history
+		%s ORDER BY id DESC LIMIT %s OFFSET %s`, where, where.placeholder(req.Limit), where.placeholder((req.Page-1)*req.Limit)),
+		where.args...)
+	if err != nil {
+		return pb.TaskHistoryResp{}, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var resp pb.TaskHistoryResp
+	for rows.Next() {
+		var (
+			change    pb.TaskChange
+			id        int64
+			fields    []byte
+			changedAt sql.NullTime
+		)
+		if err := rows.Scan(&id, &change.TaskId, &change.Action, &change.Actor, &fields, &change.Version, &changedAt); err != nil {
+			return pb.TaskHistoryResp{}, wrapError(err)
+		}
+		if err := json.Unmarshal(fields, &change.Fields); err != nil {
+			return pb.TaskHistoryResp{}, err
+		}
+		change.Id = strconv.FormatInt(id, 10)
+		_, change.ChangedTime = timestamp(changedAt)
+		resp.Changes = append(resp.Changes, &change)
+	}
+	if err := rows.Err(); err != nil {
+		return pb.TaskHistoryResp{}, wrapError(err)
+	}
+
+	err = r.db.QueryRow(`SELECT count(*) FROM task_history `+where.String(), countArgs...).Scan(&resp.Count)
+	if err != nil {
+		return pb.TaskHistoryResp{}, wrapError(err)
+	}
+
+	return resp, nil
+}
+
+// writeHistory records entries as changes made by actor at time at, with a single
+// multi-row INSERT in the transaction q runs in. Each row keeps the workspace, owner and
+// assignee of the task after the change, which History limits reads by.
+func writeHistory(q querier, actor string, at time.Time, entries ...historyEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var (
+		values []string
+		args   []interface{}
+	)
+	for _, e := range entries {
+		changes := repo.FieldChanges(e.before, e.after)
+		if changes == nil {
+			changes = []*pb.FieldChange{}
+		}
+		fields, err := json.Marshal(changes)
+		if err != nil {
+			return err
+		}
+
+		args = append(args, e.after.Id, e.action, actor, string(fields), e.after.Version, at,
+			e.after.WorkspaceId, e.after.Owner, e.after.Assignee)
+		placeholders := make([]string, historyColumns)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", len(args)-historyColumns+j+1)
+		}
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+	}
+
+	_, err := q.Exec(`INSERT INTO task_history(task_id, action, actor, fields, version, changed_at, workspace_id, owner, assignee)
+		VALUES `+strings.Join(values, ", "), args...)
+	return err
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// listenPing is how often an idle listener checks its connection.
+const listenPing = 90 * time.Second
+
+// ListenRelayed calls relayed whenever a relay of any service instance has delivered events,
+// and after the listener reconnected, as notifications sent meanwhile are lost. It opens a
+// connection of its own with conninfo and returns once ctx is done.
+func ListenRelayed(ctx context.Context, conninfo string, relayed func()) error {
+	listener := pq.NewListener(conninfo, time.Second, time.Minute, nil)
+	defer listener.Close() // nolint:errcheck
+
+	if err := listener.Listen(RelayedChannel); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(listenPing)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-listener.Notify:
+			// A nil notification tells of a reconnect.
+			relayed()
+		case <-ticker.C:
+			go listener.Ping() // nolint:errcheck
+		}
+	}
+}
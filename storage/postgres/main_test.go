@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"github.com/NafisaTojiboyeva/todo-service/config"
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
 	"github.com/NafisaTojiboyeva/todo-service/pkg/db"
 	"github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+
+	"github.com/gogo/protobuf/types"
 )
 
 var pgRepo *taskRepo
@@ -24,3 +27,25 @@ func TestMain(m *testing.M) {
 
 	os.Exit(m.Run())
 }
+
+// dates moves the times of task into its string fields as the days they fall on in UTC,
+// so tables can spell them out.
+func dates(t *testing.T, task *pb.Task) {
+	t.Helper()
+
+	day := func(ts *types.Timestamp) string {
+		if ts == nil {
+			return ""
+		}
+		tm, err := types.TimestampFromProto(ts)
+		if err != nil {
+			t.Fatalf("bad timestamp %v: %v", ts, err)
+		}
+		return tm.UTC().Format("2006-01-02")
+	}
+
+	task.Deadline, task.DeadlineTime = day(task.DeadlineTime), nil
+	task.CreatedAt, task.CreatedTime = day(task.CreatedTime), nil
+	task.UpdatedAt, task.UpdatedTime = day(task.UpdatedTime), nil
+	task.DeletedAt, task.DeletedTime = day(task.DeletedTime), nil
+}
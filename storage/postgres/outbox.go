@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// relayLock is the advisory lock key a relay holds while it claims or marks events, "outbox"
+// in ASCII.
+const relayLock = 0x6f7574626f78
+
+// outboxColumns is the number of columns writeEvents writes per row.
+const outboxColumns = 5
+
+// RelayedChannel is the channel a relay notifies with the last revision it delivered.
+const RelayedChannel = "outbox_relayed"
+
+const eventColumns = "seq, coalesce(revision, 0), event_id, event, task, created_at"
+
+var taskMarshaler = jsonpb.Marshaler{OrigName: true}
+
+type outboxRepo struct {
+	db *sqlx.DB
+}
+
+// NewOutboxRepo ...
+func NewOutboxRepo(db *sqlx.DB) *outboxRepo {
+	return &outboxRepo{db: db}
+}
+
+// Relay publishes outside of any transaction, so a slow publisher holds neither a
+// connection nor a lock. It claims the events for lease first, in a short transaction
+// under an advisory lock, so relays of several service instances take turns: one that
+// finds a claim in force hands nothing over. The published events are marked delivered
+// and numbered in a second short transaction under the same lock, which notifies
+// RelayedChannel along with its commit and lifts the claim.
+func (r *outboxRepo) Relay(limit int, at time.Time, lease time.Duration, publish func(events []repo.Event) int) (int, error) {
+	claim := at.Add(lease)
+	events, err := r.claim(limit, at, claim)
+	if err != nil || len(events) == 0 {
+		return 0, wrapError(err)
+	}
+
+	n := publish(events)
+	if n < 0 {
+		n = 0
+	}
+	if n > len(events) {
+		n = len(events)
+	}
+
+	n, err = r.deliver(events[:n], at, claim)
+	return n, wrapError(err)
+}
+
+// claim claims up to limit pending events until claim, unless another relay's claim is
+// still in force at time at.
+func (r *outboxRepo) claim(limit int, at, claim time.Time) ([]repo.Event, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() // nolint:errcheck
+
+	var locked bool
+	if err := tx.QueryRow(`SELECT pg_try_advisory_xact_lock($1)`, relayLock).Scan(&locked); err != nil || !locked {
+		return nil, err
+	}
+	var claimed bool
+	if err := tx.QueryRow(`SELECT exists(SELECT 1 FROM outbox WHERE claimed_until > $1)`, at).Scan(&claimed); err != nil || claimed {
+		return nil, err
+	}
+
+	events, err := pendingEvents(tx, limit)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	seqs := make([]int64, len(events))
+	for i, e := range events {
+		seqs[i] = e.Seq
+	}
+	if _, err := tx.Exec(`UPDATE outbox SET claimed_until=$1 WHERE seq = ANY($2)`, claim, pq.Array(seqs)); err != nil {
+		return nil, err
+	}
+
+	return events, tx.Commit()
+}
+
+// deliver marks the published events delivered at time at and lifts claim. Events whose
+// claim ran out and was taken over by another relay are left to that relay, so it reports
+// how many events it marked.
+func (r *outboxRepo) deliver(published []repo.Event, at, claim time.Time) (int, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback() // nolint:errcheck
+
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock($1)`, relayLock); err != nil {
+		return 0, err
+	}
+
+	seqs := make([]int64, 0, len(published))
+	for _, e := range published {
+		seqs = append(seqs, e.Seq)
+	}
+	var claimed []int64
+	err = tx.Select(&claimed, `SELECT seq FROM outbox WHERE seq = ANY($1) and claimed_until = $2 and delivered_at is null ORDER BY seq`,
+		pq.Array(seqs), claim)
+	if err != nil {
+		return 0, err
+	}
+
+	n := len(claimed)
+	if n > 0 {
+		var last int64
+		if err := tx.QueryRow(`SELECT coalesce(max(revision), 0) FROM outbox`).Scan(&last); err != nil {
+			return 0, err
+		}
+		revisions := make([]int64, n)
+		for i := range claimed {
+			revisions[i] = last + int64(i) + 1
+		}
+		_, err = tx.Exec(`UPDATE outbox o SET delivered_at=$1, revision=d.revision
+			FROM unnest($2::bigint[], $3::bigint[]) AS d(seq, revision) WHERE o.seq = d.seq`,
+			at, pq.Array(claimed), pq.Array(revisions))
+		if err != nil {
+			return 0, err
+		}
+		if _, err := tx.Exec(`SELECT pg_notify($1, $2)`, RelayedChannel, strconv.FormatInt(last+int64(n), 10)); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := tx.Exec(`UPDATE outbox SET claimed_until=null WHERE claimed_until = $1`, claim); err != nil {
+		return 0, err
+	}
+
+	return n, tx.Commit()
+}
+
+func (r *outboxRepo) Since(revision int64, limit int) ([]repo.Event, error) {
+	events, err := queryEvents(r.db, `SELECT `+eventColumns+` FROM outbox
+		WHERE revision > $1 ORDER BY revision LIMIT $2`, revision, limit)
+	return events, wrapError(err)
+}
+
+func (r *outboxRepo) LastRevision() (int64, error) {
+	var revision int64
+	err := r.db.QueryRow(`SELECT coalesce(max(revision), 0) FROM outbox`).Scan(&revision)
+	return revision, wrapError(err)
+}
+
+func pendingEvents(q querier, limit int) ([]repo.Event, error) {
+	return queryEvents(q, `SELECT `+eventColumns+` FROM outbox
+		WHERE delivered_at is null ORDER BY seq LIMIT $1`, limit)
+}
+
+func queryEvents(q querier, query string, args ...interface{}) ([]repo.Event, error) {
+	rows, err := q.Queryx(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var events []repo.Event
+	for rows.Next() {
+		var (
+			e    repo.Event
+			task []byte
+		)
+		if err := rows.Scan(&e.Seq, &e.Revision, &e.ID, &e.Type, &task, &e.OccurredAt); err != nil {
+			return nil, err
+		}
+		if err := jsonpb.Unmarshal(bytes.NewReader(task), &e.Task); err != nil {
+			return nil, fmt.Errorf("outbox event %d: %w", e.Seq, err)
+		}
+		e.OccurredAt = e.OccurredAt.UTC()
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+// writeEvent adds the events of types about task, which occurred at time at, to the outbox,
+// as part of the transaction q runs in.
+func writeEvent(q querier, at time.Time, task pb.Task, types ...repo.EventType) error {
+	events := make([]repo.Event, len(types))
+	for i, t := range types {
+		events[i] = repo.Event{Type: t, Task: task}
+	}
+	return writeEvents(q, at, events)
+}
+
+// writeEvents adds events to the outbox with a single multi-row INSERT, giving each a new
+// id and time at, the time of the change they are about.
+func writeEvents(q querier, at time.Time, events []repo.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var (
+		values []string
+		args   []interface{}
+	)
+	for _, e := range events {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		var task bytes.Buffer
+		if err := taskMarshaler.Marshal(&task, &e.Task); err != nil {
+			return err
+		}
+
+		args = append(args, id.String(), string(e.Type), e.Task.Id, task.String(), at)
+		placeholders := make([]string, outboxColumns)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", len(args)-outboxColumns+j+1)
+		}
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+	}
+
+	_, err := q.Exec(`INSERT INTO outbox(event_id, event, task_id, task, created_at) VALUES `+strings.Join(values, ", "), args...)
+	return err
+}
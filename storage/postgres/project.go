@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// projectColumns are the projects columns scanProject scans.
+const projectColumns = "id, workspace_id, name, description, color, archived, created_at, updated_at"
+
+type projectRepo struct {
+	db *sqlx.DB
+
+	// workspace limits the repository to the projects of a workspace when set.
+	workspace string
+}
+
+// NewProjectRepo ...
+func NewProjectRepo(db *sqlx.DB) *projectRepo {
+	return &projectRepo{db: db}
+}
+
+func (r *projectRepo) InWorkspace(id string) repo.ProjectStorageI {
+	scoped := *r
+	scoped.workspace = id
+	return &scoped
+}
+
+func (r *projectRepo) Create(project pb.Project) (pb.Project, error) {
+	workspace := project.WorkspaceId
+	if r.workspace != "" {
+		workspace = r.workspace
+	}
+	if workspace == "" {
+		workspace = repo.DefaultWorkspaceID
+	}
+
+	now := time.Now()
+	project, err := scanProject(r.db.QueryRow(`
+		INSERT INTO projects(id, workspace_id, name, description, color, archived, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING `+projectColumns,
+		project.Id, workspace, project.Name, project.Description, project.Color, project.Archived, now, now))
+	if err != nil {
+		return pb.Project{}, wrapError(err)
+	}
+
+	return project, nil
+}
+
+func (r *projectRepo) Get(id string) (pb.Project, error) {
+	where := r.where()
+	where.add("id = $%d", id)
+	project, err := scanProject(r.db.QueryRow(`SELECT `+projectColumns+` FROM projects `+where.String(), where.args...))
+	if err != nil {
+		return pb.Project{}, wrapError(err)
+	}
+
+	return project, nil
+}
+
+func (r *projectRepo) List(req pb.ListProjectsReq) (pb.ListProjectsResp, error) {
+	where := r.where()
+	if !req.IncludeArchived {
+		where.addRaw("not archived")
+	}
+	countArgs := where.args
+
+	rows, err := r.db.Queryx(`SELECT `+projectColumns+` FROM projects `+where.String()+
+		` ORDER BY created_at, id LIMIT `+where.placeholder(req.Limit)+` OFFSET `+where.placeholder((req.Page-1)*req.Limit),
+		where.args...)
+	if err != nil {
+		return pb.ListProjectsResp{}, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var resp pb.ListProjectsResp
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return pb.ListProjectsResp{}, wrapError(err)
+		}
+		resp.Projects = append(resp.Projects, &project)
+	}
+	if err := rows.Err(); err != nil {
+		return pb.ListProjectsResp{}, wrapError(err)
+	}
+
+	err = r.db.QueryRow(`SELECT count(*) FROM projects `+where.String(), countArgs...).Scan(&resp.Count)
+	if err != nil {
+		return pb.ListProjectsResp{}, wrapError(err)
+	}
+
+	return resp, nil
+}
+
+func (r *projectRepo) Update(project pb.Project) (pb.Project, error) {
+	where := newWhereBuilder("true")
+	set := fmt.Sprintf("name=%s, description=%s, color=%s, archived=%s, updated_at=%s",
+		where.placeholder(project.Name), where.placeholder(project.Description), where.placeholder(project.Color),
+		where.placeholder(project.Archived), where.placeholder(time.Now()))
+	where.add("id = $%d", project.Id)
+	where.addIf("workspace_id = $%d", r.workspace)
+
+	project, err := scanProject(r.db.QueryRow(`UPDATE projects SET `+set+` `+where.String()+` RETURNING `+projectColumns, where.args...))
+	if err != nil {
+		return pb.Project{}, wrapError(err)
+	}
+
+	return project, nil
+}
+
+func (r *projectRepo) Delete(id string) error {
+	where := r.where()
+	where.add("id = $%d", id)
+	result, err := r.db.Exec(`DELETE FROM projects `+where.String(), where.args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		return repo.ErrInUse
+	}
+	if err != nil {
+		return wrapError(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return wrapError(err)
+	}
+	if deleted == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+// where starts the conditions of a projects query with the workspace the repository is
+// limited to.
+func (r *projectRepo) where() *whereBuilder {
+	where := newWhereBuilder("true")
+	where.addIf("workspace_id = $%d", r.workspace)
+	return where
+}
+
+func scanProject(row scanner) (pb.Project, error) {
+	var (
+		project              pb.Project
+		createdAt, updatedAt sql.NullTime
+	)
+	err := row.Scan(&project.Id, &project.WorkspaceId, &project.Name, &project.Description, &project.Color,
+		&project.Archived, &createdAt, &updatedAt)
+	if err != nil {
+		return pb.Project{}, err
+	}
+
+	_, project.CreatedTime = timestamp(createdAt)
+	_, project.UpdatedTime = timestamp(updatedAt)
+	return project, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// Base conditions of a whereBuilder, selecting live or soft-deleted tasks.
+const (
+	liveTasks    = "deleted_at is null"
+	deletedTasks = "deleted_at is not null"
+)
+
+// sortColumns maps the sort_by values accepted by ListReq to todos columns.
+var sortColumns = map[string]string{
+	"":           "created_at",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"deadline":   "deadline",
+	"title":      "title",
+	"assignee":   "assignee",
+	"status":     "status",
+}
+
+// whereBuilder collects WHERE conditions together with their positional arguments,
+// so every user supplied value ends up as a $N parameter.
+type whereBuilder struct {
+	conds []string
+	args  []interface{}
+}
+
+func newWhereBuilder(base string) *whereBuilder {
+	return &whereBuilder{conds: []string{base}}
+}
+
+// add appends a condition; cond must contain a single %d verb for the placeholder number.
+func (w *whereBuilder) add(cond string, arg interface{}) {
+	w.args = append(w.args, arg)
+	w.conds = append(w.conds, fmt.Sprintf(cond, len(w.args)))
+}
+
+// addIf is add for optional filters that are skipped when left empty.
+func (w *whereBuilder) addIf(cond, arg string) {
+	if arg != "" {
+		w.add(cond, arg)
+	}
+}
+
+// addRaw appends a condition whose placeholders were already registered with placeholder.
+func (w *whereBuilder) addRaw(cond string) {
+	w.conds = append(w.conds, cond)
+}
+
+// placeholder registers arg and returns its $N placeholder without adding a condition.
+func (w *whereBuilder) placeholder(arg interface{}) string {
+	w.args = append(w.args, arg)
+	return fmt.Sprintf("$%d", len(w.args))
+}
+
+func (w *whereBuilder) String() string {
+	return "WHERE " + strings.Join(w.conds, " and ")
+}
+
+// listFilter adds the filters of req to w.
+func listFilter(w *whereBuilder, req pb.ListReq) *whereBuilder {
+	w.addIf("assignee = $%d", req.Assignee)
+	w.addIf("status = $%d", req.Status)
+	w.addIf("deadline >= $%d", req.DeadlineFrom)
+	w.addIf("deadline <= $%d", req.DeadlineTo)
+	w.addIf("created_at >= $%d", req.CreatedFrom)
+	w.addIf("created_at <= $%d", req.CreatedTo)
+	w.addIf("series_id = $%d", req.SeriesId)
+	w.addIf("project_id = $%d", req.ProjectId)
+	if req.ProjectId == "" && !req.IncludeArchived {
+		w.addRaw("NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = todos.project_id and projects.archived)")
+	}
+	return w
+}
+
+// descending tells whether sortOrder sorts from the last to the first: asc, the
+// default, or desc in any case.
+func descending(sortOrder string) (bool, error) {
+	switch strings.ToLower(sortOrder) {
+	case "", "asc":
+		return false, nil
+	case "desc":
+		return true, nil
+	}
+	return false, &repo.FieldError{Field: "sort_order", Description: fmt.Sprintf("unsupported sort order %q", sortOrder)}
+}
+
+func orderBy(sortBy, sortOrder string) (string, error) {
+	column, ok := sortColumns[sortBy]
+	if !ok {
+		return "", &repo.FieldError{Field: "sort_by", Description: fmt.Sprintf("unsupported sort field %q", sortBy)}
+	}
+
+	desc, err := descending(sortOrder)
+	if err != nil {
+		return "", err
+	}
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+
+	return fmt.Sprintf("ORDER BY %s %s NULLS LAST, id %s", column, direction, direction), nil
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type reminderRepo struct {
+	db *sqlx.DB
+}
+
+// NewReminderRepo ...
+func NewReminderRepo(db *sqlx.DB) *reminderRepo {
+	return &reminderRepo{db: db}
+}
+
+func (r *reminderRepo) Due(kind repo.ReminderKind, from, to, now time.Time, limit int) ([]pb.Task, error) {
+	where := newWhereBuilder(liveTasks)
+	where.addRaw(`status NOT IN ('done', 'cancelled')`)
+	if !from.IsZero() {
+		where.add("deadline >= $%d", from)
+	}
+	where.add("deadline < $%d", to)
+	where.addRaw(fmt.Sprintf(`NOT EXISTS (SELECT 1 FROM reminders WHERE task_id = todos.id and kind = %s and reminders.deadline = todos.deadline
+		and (retry_at IS NULL or retry_at > %s))`, where.placeholder(string(kind)), where.placeholder(now)))
+	limitArg := where.placeholder(limit)
+
+	rows, err := r.db.Queryx(fmt.Sprintf(`SELECT %s FROM todos %s ORDER BY deadline, id LIMIT %s`, taskColumns, where, limitArg), where.args...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var tasks []pb.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, wrapError(err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, wrapError(rows.Err())
+}
+
+func (r *reminderRepo) Claim(reminder repo.Reminder, at time.Time) (int, error) {
+	var attempt int
+	err := r.db.QueryRow(`INSERT INTO reminders(task_id, kind, deadline, sent_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (task_id, kind, deadline) DO UPDATE SET sent_at = EXCLUDED.sent_at, attempts = reminders.attempts + 1, retry_at = NULL
+		WHERE reminders.retry_at <= EXCLUDED.sent_at
+		RETURNING attempts`, reminder.TaskID, string(reminder.Kind), reminder.Deadline, at).Scan(&attempt)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return attempt, wrapError(err)
+}
+
+func (r *reminderRepo) Fail(reminder repo.Reminder, retryAt time.Time) error {
+	var retry sql.NullTime
+	if !retryAt.IsZero() {
+		retry = sql.NullTime{Time: retryAt, Valid: true}
+	}
+	_, err := r.db.Exec(`UPDATE reminders SET retry_at=$4, failed=$5 WHERE task_id=$1 and kind=$2 and deadline=$3`,
+		reminder.TaskID, string(reminder.Kind), reminder.Deadline, retry, !retry.Valid)
+	return wrapError(err)
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"fmt"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// searchConfig is the text search configuration todos.search is built with,
+// see migrations/000005_task_search.up.sql.
+const searchConfig = "english"
+
+// searchColumns are listColumns followed by the snippets and the rank of a match against q.
+const searchColumns = listColumns + `,
+	ts_headline('` + searchConfig + `', coalesce(title, ''), q, 'HighlightAll=true'),
+	ts_headline('` + searchConfig + `', coalesce(summary, ''), q, 'MaxFragments=2, MaxWords=15, MinWords=5'),
+	ts_rank(search, q) AS rank`
+
+func (r *taskRepo) Search(req pb.SearchReq) (pb.SearchResp, error) {
+	where := r.where(liveTasks)
+	from := fmt.Sprintf("todos, websearch_to_tsquery('%s', %s) q", searchConfig, where.placeholder(req.Query))
+	where.addRaw("search @@ q")
+	where.addIf("assignee = $%d", req.Assignee)
+	where.addIf("status = $%d", req.Status)
+
+	if req.PageToken != "" || req.Page == 0 {
+		return r.searchByCursor(from, where, req.PageToken, req.Limit)
+	}
+
+	return r.searchByOffset(from, where, req.Page, req.Limit)
+}
+
+func (r *taskRepo) searchByOffset(from string, where *whereBuilder, page, limit int64) (pb.SearchResp, error) {
+	countArgs := where.args
+	limitArg, offsetArg := where.placeholder(limit), where.placeholder((page-1)*limit)
+	results, err := r.selectResults(
+		fmt.Sprintf(`SELECT %s FROM %s %s ORDER BY rank DESC, id LIMIT %s OFFSET %s`, searchColumns, from, where, limitArg, offsetArg),
+		where.args...)
+	if err != nil {
+		return pb.SearchResp{}, wrapError(err)
+	}
+
+	var count int64
+	err = r.db.QueryRow(fmt.Sprintf(`SELECT count(*) FROM %s %s`, from, where), countArgs...).Scan(&count)
+	if err != nil {
+		return pb.SearchResp{}, wrapError(err)
+	}
+
+	return pb.SearchResp{
+		Results: results,
+		Count:   count,
+	}, nil
+}
+
+// searchByCursor is listByCursor for Search, paging in (rank DESC, id) order.
+func (r *taskRepo) searchByCursor(from string, where *whereBuilder, token string, limit int64) (pb.SearchResp, error) {
+	if limit <= 0 {
+		return pb.SearchResp{}, &repo.FieldError{Field: "limit", Description: "must be positive when paging with tokens"}
+	}
+
+	if token != "" {
+		after, err := repo.DecodeSearchToken(token)
+		if err != nil {
+			return pb.SearchResp{}, wrapError(err)
+		}
+		rank, id := where.placeholder(after.Rank), where.placeholder(after.ID)
+		where.addRaw(fmt.Sprintf("(ts_rank(search, q) < %s::real or (ts_rank(search, q) = %s::real and id > %s::uuid))", rank, rank, id))
+	}
+
+	limitArg := where.placeholder(limit + 1)
+	results, err := r.selectResults(
+		fmt.Sprintf(`SELECT %s FROM %s %s ORDER BY rank DESC, id LIMIT %s`, searchColumns, from, where, limitArg),
+		where.args...)
+	if err != nil {
+		return pb.SearchResp{}, wrapError(err)
+	}
+
+	var resp pb.SearchResp
+	if int64(len(results)) > limit {
+		results = results[:limit]
+		last := results[len(results)-1]
+		resp.NextPageToken = repo.SearchToken{Rank: last.Rank, ID: last.Task.Id}.Encode()
+	}
+	resp.Results = results
+
+	return resp, nil
+}
+
+func (r *taskRepo) selectResults(query string, args ...interface{}) ([]*pb.SearchResult, error) {
+	rows, err := r.db.Queryx(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var results []*pb.SearchResult
+	for rows.Next() {
+		var result pb.SearchResult
+		result.Task, err = scanListedTask(rows, &result.TitleSnippet, &result.SummarySnippet, &result.Rank)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &result)
+	}
+
+	return results, rows.Err()
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// subtreeOf is the recursive CTE of the ids of the subtasks of task %[1]s at any depth,
+// with their depth, that match cond. Both are written into the query, in which cond
+// names the todos columns it reads with the todos qualifier.
+const subtreeOf = `WITH RECURSIVE subtree(id, depth) AS (
+		SELECT id, 1 FROM todos WHERE parent_id = %[1]s and %[2]s
+		UNION ALL
+		SELECT todos.id, subtree.depth + 1 FROM todos JOIN subtree ON todos.parent_id = subtree.id WHERE %[2]s%[3]s
+	) `
+
+func (r *taskRepo) ListSubtasks(req pb.ListSubtasksReq) (pb.ListResp, error) {
+	if _, err := r.Get(req.ParentId); err != nil {
+		return pb.ListResp{}, err
+	}
+
+	// The tree is walked through every subtask, but lists only those the repository sees.
+	where := r.where("true")
+	deeper := ""
+	if !req.Recursive {
+		deeper = " and false"
+	}
+	subtree := fmt.Sprintf(subtreeOf, where.placeholder(req.ParentId), "todos.deleted_at is null", deeper)
+	countArgs := where.args
+
+	limitArg, offsetArg := where.placeholder(req.Limit), where.placeholder((req.Page-1)*req.Limit)
+	tasks, err := r.selectTasks(fmt.Sprintf(`%s SELECT %s FROM todos JOIN subtree USING (id) %s
+		ORDER BY subtree.depth, created_at, id LIMIT %s OFFSET %s`, subtree, listColumns, where, limitArg, offsetArg),
+		where.args...)
+	if err != nil {
+		return pb.ListResp{}, wrapError(err)
+	}
+
+	var count int64
+	err = r.db.QueryRow(subtree+`SELECT count(*) FROM todos JOIN subtree USING (id) `+where.String(), countArgs...).Scan(&count)
+	if err != nil {
+		return pb.ListResp{}, wrapError(err)
+	}
+
+	return pb.ListResp{
+		Tasks: tasks,
+		Count: count,
+	}, nil
+}
+
+// checkParent makes sure task id can be put under parent: a live task the repository
+// sees, that is neither id itself nor one of its subtasks. The todos_parent_fkey foreign
+// key keeps both in the same workspace.
+func (r *taskRepo) checkParent(q querier, id, parent string) error {
+	where := r.where(liveTasks)
+	where.add("id = $%d", parent)
+	var exists bool
+	err := q.QueryRow(`SELECT exists(SELECT 1 FROM todos `+where.String()+`)`, where.args...).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return &repo.FieldError{Field: "parent_id", Description: "no such task"}
+	}
+
+	// UNION rather than UNION ALL ends the walk up even on a cycle that slipped through.
+	var cycle bool
+	err = q.QueryRow(`WITH RECURSIVE ancestors(id, parent_id) AS (
+			SELECT id, parent_id FROM todos WHERE id = $1
+			UNION
+			SELECT todos.id, todos.parent_id FROM todos JOIN ancestors ON todos.id = ancestors.parent_id
+		)
+		SELECT exists(SELECT 1 FROM ancestors WHERE id = $2)`, parent, id).Scan(&cycle)
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return &repo.FieldError{Field: "parent_id", Description: "a task can't be a subtask of itself or of its own subtasks"}
+	}
+
+	return nil
+}
+
+// moveLock serializes the moves of tasks under new parents until the transaction q runs
+// in ends, so that two moves can't each pass checkParent and make a cycle together.
+func (r *taskRepo) moveLock(q querier) error {
+	_, err := q.Exec(`SELECT pg_advisory_xact_lock(hashtext('todos_parent_fkey'))`)
+	return err
+}
+
+// writesParent tells whether a patch of fields moves the task.
+func writesParent(fields []string) bool {
+	for _, field := range fields {
+		if field == "parent_id" {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteSubtasks soft-deletes the live subtasks of task id at any depth at time at, and
+// returns them as deleted.
+func deleteSubtasks(q querier, id string, at time.Time) ([]pb.Task, error) {
+	return updateSubtasks(q, fmt.Sprintf(subtreeOf, "$1", "todos.deleted_at is null", "")+
+		`UPDATE todos SET deleted_at=$2, version=version+1 WHERE id IN (SELECT id FROM subtree) RETURNING `+taskColumns, id, at)
+}
+
+// restoreSubtasks restores the subtasks of task id at any depth that were deleted along
+// with it, at deletedAt, and returns them as restored at time at.
+func restoreSubtasks(q querier, id string, deletedAt, at time.Time) ([]pb.Task, error) {
+	return updateSubtasks(q, fmt.Sprintf(subtreeOf, "$1", "todos.deleted_at = $2", "")+
+		`UPDATE todos SET deleted_at=null, updated_at=$3, version=version+1 WHERE id IN (SELECT id FROM subtree) RETURNING `+taskColumns,
+		id, deletedAt, at)
+}
+
+func updateSubtasks(q querier, query string, args ...interface{}) ([]pb.Task, error) {
+	rows, err := q.Queryx(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var tasks []pb.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
@@ -2,157 +2,659 @@ package postgres
 
 import (
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 
 	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
 )
 
+// progressColumns roll the live subtasks of a task up into how many there are and how
+// many of them are done. They end both listColumns and taskColumns.
+const progressColumns = `
+	(SELECT count(*) FROM todos subtasks WHERE subtasks.parent_id = todos.id and subtasks.deleted_at is null),
+	(SELECT count(*) FROM todos subtasks WHERE subtasks.parent_id = todos.id and subtasks.deleted_at is null and subtasks.status = 'done')`
+
+// listColumns are the todos columns selectTasks scans.
+const listColumns = "id, assignee, title, summary, deadline, status, created_at, version, deleted_at, time_zone, recurrence, series_id, occurrence, owner, workspace_id, project_id, parent_id," + progressColumns
+
+// taskColumns are the todos columns scanTask scans.
+const taskColumns = "id, assignee, title, summary, deadline, status, created_at, updated_at, version, time_zone, recurrence, series_id, occurrence, owner, workspace_id, project_id, parent_id," + progressColumns
+
+// taskFields are the fields a client may write, in the order Update writes them.
+var taskFields = []string{"assignee", "title", "summary", "deadline", "status", "recurrence", "project_id", "parent_id"}
+
+// fieldColumns are the columns each of taskFields is stored in: a deadline goes with the
+// zone it was given in, a recurrence with the series it belongs to.
+var fieldColumns = map[string][]string{
+	"assignee":   {"assignee"},
+	"title":      {"title"},
+	"summary":    {"summary"},
+	"deadline":   {"deadline", "time_zone"},
+	"status":     {"status"},
+	"recurrence": {"recurrence", "series_id", "occurrence"},
+	"project_id": {"project_id"},
+	"parent_id":  {"parent_id"},
+}
+
+// querier is implemented by both *sqlx.DB and *sqlx.Tx, so the same statements
+// can run on their own or as part of a batch.
+type querier interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+	Queryx(query string, args ...interface{}) (*sqlx.Rows, error)
+}
+
 type taskRepo struct {
-	db *sqlx.DB
+	db        *sqlx.DB
+	actor     string
+	visibleTo string
+	workspace string
+	clock     func() time.Time
 }
 
 // NewTaskRepo ...
 func NewTaskRepo(db *sqlx.DB) *taskRepo {
-	return &taskRepo{db: db}
+	return &taskRepo{db: db, clock: time.Now}
+}
+
+// SetClock makes the repository read the time from clock, so tests can control
+// created_at, updated_at and deleted_at. It is not safe to call while the repository
+// is in use.
+func (r *taskRepo) SetClock(clock func() time.Time) {
+	r.clock = clock
+}
+
+// now reads the clock.
+func (r *taskRepo) now() time.Time {
+	return r.clock()
+}
+
+func (r *taskRepo) WithActor(actor string) repo.TaskStorageI {
+	scoped := *r
+	scoped.actor = actor
+	return &scoped
+}
+
+func (r *taskRepo) VisibleTo(user string) repo.TaskStorageI {
+	scoped := *r
+	scoped.visibleTo = user
+	return &scoped
+}
+
+func (r *taskRepo) InWorkspace(id string) repo.TaskStorageI {
+	scoped := *r
+	scoped.workspace = id
+	return &scoped
+}
+
+// where starts the WHERE clause of a statement on the tasks of base the repository
+// may see and change.
+func (r *taskRepo) where(base string) *whereBuilder {
+	w := newWhereBuilder(base)
+	for _, cond := range r.limits(w) {
+		w.addRaw(cond)
+	}
+	return w
+}
+
+// limits returns the conditions on todos that keep the repository to its workspace and
+// the tasks of its user, with their arguments registered in w.
+func (r *taskRepo) limits(w *whereBuilder) []string {
+	var conds []string
+	if r.workspace != "" {
+		conds = append(conds, "workspace_id = "+w.placeholder(r.workspace))
+	}
+	if r.visibleTo != "" {
+		user := w.placeholder(r.visibleTo)
+		conds = append(conds, fmt.Sprintf("(owner = %s or assignee = %s)", user, user))
+	}
+	return conds
+}
+
+// workspaceOf is the workspace task is created in: the one of the repository, else the
+// one task names, else the default one.
+func (r *taskRepo) workspaceOf(task pb.Task) string {
+	switch {
+	case r.workspace != "":
+		return r.workspace
+	case task.WorkspaceId != "":
+		return task.WorkspaceId
+	}
+	return repo.DefaultWorkspaceID
 }
 
 func (r *taskRepo) Create(task pb.Task) (pb.Task, error) {
-	var id string
-	err := r.db.QueryRow(`
-		INSERT INTO todos(id, assignee, title, summary, deadline, status, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7) returning id`, task.Id, task.Assignee, task.Title, task.Summary, task.Deadline, task.Status, time.Now()).Scan(&id)
+	err := r.inTx(func(tx *sqlx.Tx) (err error) {
+		task, err = r.insertTask(tx, task)
+		return err
+	})
 	if err != nil {
-		return pb.Task{}, err
+		return pb.Task{}, wrapError(err)
 	}
 
-	task, err = r.Get(id)
+	return task, nil
+}
+
+func (r *taskRepo) Get(id string) (pb.Task, error) {
+	where := r.where(liveTasks)
+	where.add("id = $%d", id)
+	task, err := scanTask(r.db.QueryRow(`SELECT `+taskColumns+` FROM todos `+where.String(), where.args...))
 	if err != nil {
-		return pb.Task{}, err
+		return pb.Task{}, wrapError(err)
 	}
 
 	return task, nil
 }
 
-func (r *taskRepo) Get(id string) (pb.Task, error) {
-	var task pb.Task
-	var updatedAt sql.NullString
-	err := r.db.QueryRow(`
-		SELECT id, assignee, title, summary, deadline, status, created_at, updated_at FROM todos
-		WHERE id=$1 and deleted_at is null`, id).Scan(&task.Id, &task.Assignee, &task.Title, &task.Summary, &task.Deadline, &task.Status, &task.CreatedAt, &updatedAt)
+func (r *taskRepo) List(req pb.ListReq) (pb.ListResp, error) {
+	return r.list(liveTasks, req)
+}
+
+// ListDeleted lists soft-deleted tasks with the same filters and paging as List.
+func (r *taskRepo) ListDeleted(req pb.ListReq) (pb.ListResp, error) {
+	return r.list(deletedTasks, req)
+}
+
+func (r *taskRepo) list(base string, req pb.ListReq) (pb.ListResp, error) {
+	where := listFilter(r.where(base), req)
+	if req.PageToken != "" || req.Page == 0 {
+		if sortColumns[req.SortBy] != "created_at" {
+			return pb.ListResp{}, &repo.FieldError{Field: "sort_by", Description: "only created_at is supported with page tokens"}
+		}
+		desc, err := descending(req.SortOrder)
+		if err != nil {
+			return pb.ListResp{}, wrapError(err)
+		}
+		return r.listByCursor(where, req.PageToken, desc, req.Limit)
+	}
+
+	order, err := orderBy(req.SortBy, req.SortOrder)
 	if err != nil {
-		return pb.Task{}, err
+		return pb.ListResp{}, wrapError(err)
 	}
 
-	if !updatedAt.Valid {
-		updatedAt.String = ""
+	return r.listByOffset(where, order, req.Page, req.Limit)
+}
+
+func (r *taskRepo) Update(task pb.Task) (pb.Task, error) {
+	return r.Patch(task, taskFields)
+}
+
+// Patch writes only the given fields of task, leaving the other columns untouched.
+// An empty deadline clears it.
+func (r *taskRepo) Patch(task pb.Task, fields []string) (pb.Task, error) {
+	err := r.inTx(func(tx *sqlx.Tx) (err error) {
+		task, err = r.patchTask(tx, task, fields)
+		return err
+	})
+	if err != nil {
+		return pb.Task{}, wrapError(err)
 	}
-	task.UpdatedAt = updatedAt.String
+
 	return task, nil
 }
 
-func (r *taskRepo) List(page, limit int64) ([]*pb.Task, int64, error) {
-	offset := (page - 1) * limit
-	rows, err := r.db.Queryx(
-		`SELECT id, assignee, title, summary, deadline, status, created_at FROM todos WHERE deleted_at is null LIMIT $1 OFFSET $2`,
-		limit, offset)
+// ChangeStatus moves the task to status to only if it is still in status from,
+// so a concurrent change can't be overwritten with an unchecked transition.
+func (r *taskRepo) ChangeStatus(id, from, to string) (pb.Task, error) {
+	var task pb.Task
+	err := r.inTx(func(tx *sqlx.Tx) (err error) {
+		where := r.where(liveTasks)
+		where.add("id = $%d", id)
+		where.add("status = $%d", from)
+		now := r.now()
+		task, err = scanTask(tx.QueryRow(fmt.Sprintf(`UPDATE todos SET status=%s, updated_at=%s, version=version+1 %s RETURNING %s`,
+			where.placeholder(to), where.placeholder(now), where, taskColumns), where.args...))
+		if err == sql.ErrNoRows {
+			return r.missingOrConflict(tx, id)
+		}
+		if err != nil {
+			return err
+		}
+		before := task
+		before.Status = from
+		if err := writeHistory(tx, r.actor, now, historyEntry{action: repo.ActionUpdate, before: before, after: task}); err != nil {
+			return err
+		}
+		return writeEvent(tx, now, task, repo.ChangeEvents(from, task)...)
+	})
 	if err != nil {
-		return nil, 0, err
-	}
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+		return pb.Task{}, wrapError(err)
 	}
-	defer rows.Close() // nolint:errcheck
 
-	var (
-		tasks []*pb.Task
-		count int64
-	)
+	return task, nil
+}
 
-	for rows.Next() {
-		var task pb.Task
-		err = rows.Scan(&task.Id, &task.Assignee, &task.Title, &task.Summary, &task.Deadline, &task.Status, &task.CreatedAt)
+func (r *taskRepo) Delete(id string, version int64) error {
+	return wrapError(r.inTx(func(tx *sqlx.Tx) error {
+		return r.deleteTask(tx, id, version)
+	}))
+}
+
+// Restore undoes Delete. Only soft-deleted tasks can be restored.
+func (r *taskRepo) Restore(id string) (pb.Task, error) {
+	var task pb.Task
+	err := r.inTx(func(tx *sqlx.Tx) (err error) {
+		where := r.where(deletedTasks)
+		where.add("id = $%d", id)
+		var (
+			deletedAt     sql.NullTime
+			parentDeleted bool
+		)
+		err = tx.QueryRow(`SELECT deleted_at, exists(SELECT 1 FROM todos parent WHERE parent.id = todos.parent_id and parent.deleted_at is not null)
+			FROM todos `+where.String()+` FOR UPDATE`, where.args...).Scan(&deletedAt, &parentDeleted)
+		if err != nil {
+			return err
+		}
+		if parentDeleted {
+			return repo.ErrParentDeleted
+		}
+
+		// The subtasks come back first, for the task to roll them up.
+		now := r.now()
+		subtasks, err := restoreSubtasks(tx, id, deletedAt.Time, now)
+		if err != nil {
+			return err
+		}
+		task, err = scanTask(tx.QueryRow(fmt.Sprintf(`UPDATE todos SET deleted_at=null, updated_at=%s, version=version+1 %s RETURNING %s`,
+			where.placeholder(now), where, taskColumns), where.args...))
 		if err != nil {
-			return nil, 0, err
+			return err
+		}
+
+		entries := []historyEntry{{action: repo.ActionRestore, before: task, after: task}}
+		events := []repo.Event{{Type: repo.EventTaskUpdated, Task: task}}
+		for _, subtask := range subtasks {
+			entries = append(entries, historyEntry{action: repo.ActionRestore, before: subtask, after: subtask})
+			events = append(events, repo.Event{Type: repo.EventTaskUpdated, Task: subtask})
+		}
+		if err := writeHistory(tx, r.actor, now, entries...); err != nil {
+			return err
 		}
-		tasks = append(tasks, &task)
+		return writeEvents(tx, now, events)
+	})
+	if err != nil {
+		return pb.Task{}, wrapError(err)
 	}
 
-	err = r.db.QueryRow(`SELECT count(*) FROM todos WHERE deleted_at is null`).Scan(&count)
+	return task, nil
+}
+
+// Purge permanently removes a soft-deleted task.
+func (r *taskRepo) Purge(id string) error {
+	where := r.where(deletedTasks)
+	where.add("id = $%d", id)
+	purged, err := r.purge(where)
 	if err != nil {
-		return nil, 0, err
+		return wrapError(err)
+	}
+
+	if purged == 0 {
+		return repo.ErrNotFound
 	}
 
-	return tasks, count, nil
+	return nil
 }
 
-func (r *taskRepo) Update(task pb.Task) (pb.Task, error) {
-	result, err := r.db.Exec(`UPDATE todos SET assignee=$1, title=$2, summary=$3, deadline=$4, status=$5, updated_at=$6 WHERE id=$7 and deleted_at is null`,
-		task.Assignee, task.Title, task.Summary, task.Deadline, task.Status, time.Now(), task.Id)
+// PurgeDeletedBefore permanently removes every task soft-deleted before t and reports how many there were.
+func (r *taskRepo) PurgeDeletedBefore(t time.Time) (int64, error) {
+	where := r.where(deletedTasks)
+	where.add("deleted_at < $%d", t)
+	purged, err := r.purge(where)
+	if err != nil {
+		return 0, wrapError(err)
+	}
+
+	return purged, nil
+}
+
+// purge removes the tasks that match where along with their subtasks at any depth, and
+// returns how many it removed, subtasks included. todos_parent_fkey doesn't cascade, so
+// a task is never purged without the repository knowing; the subtasks of a deleted task
+// are deleted too, and so had their history and their event written already.
+func (r *taskRepo) purge(where *whereBuilder) (int64, error) {
+	result, err := r.db.Exec(`WITH RECURSIVE purged(id) AS (
+			SELECT id FROM todos `+where.String()+`
+			UNION ALL
+			SELECT todos.id FROM todos JOIN purged ON todos.parent_id = purged.id
+		)
+		DELETE FROM todos WHERE id IN (SELECT id FROM purged)`, where.args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// insertTask, patchTask and deleteTask write the history and the outbox events of their
+// change too, so they must run in a transaction.
+
+func (r *taskRepo) insertTask(q querier, task pb.Task) (pb.Task, error) {
+	if task.ParentId != "" {
+		if err := r.checkParent(q, task.Id, task.ParentId); err != nil {
+			return pb.Task{}, err
+		}
+	}
+
+	now := r.now()
+	inserted, err := scanTask(q.QueryRow(`
+		INSERT INTO todos(id, assignee, title, summary, deadline, status, created_at, time_zone, recurrence, series_id, occurrence, owner, workspace_id, project_id, parent_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING `+taskColumns,
+		task.Id, task.Assignee, task.Title, task.Summary, deadlineValue(task), task.Status, now,
+		task.TimeZone, task.Recurrence, nullable(task.SeriesId), task.Occurrence, task.Owner, r.workspaceOf(task), nullable(task.ProjectId),
+		nullable(task.ParentId)))
 	if err != nil {
 		return pb.Task{}, err
 	}
+	if err := writeHistory(q, r.actor, now, historyEntry{action: repo.ActionCreate, after: inserted}); err != nil {
+		return pb.Task{}, err
+	}
+
+	return inserted, writeEvent(q, now, inserted, repo.EventTaskCreated)
+}
+
+func (r *taskRepo) patchTask(q querier, task pb.Task, fields []string) (pb.Task, error) {
+	values := map[string]interface{}{
+		"assignee":   task.Assignee,
+		"title":      task.Title,
+		"summary":    task.Summary,
+		"deadline":   deadlineValue(task),
+		"time_zone":  task.TimeZone,
+		"status":     task.Status,
+		"recurrence": task.Recurrence,
+		"series_id":  nullable(task.SeriesId),
+		"occurrence": task.Occurrence,
+		"project_id": nullable(task.ProjectId),
+		"parent_id":  nullable(task.ParentId),
+	}
+
+	where := r.where(liveTasks)
+	where.add("id = $%d", task.Id)
+
+	// History compares with the task as it was, which the update then waits for.
+	before, err := scanTask(q.QueryRow(`SELECT `+taskColumns+` FROM todos `+where.String()+` FOR UPDATE`, where.args...))
+	if err != nil && err != sql.ErrNoRows {
+		return pb.Task{}, err
+	}
+	if err == nil && task.ParentId != "" && writesParent(fields) {
+		if err := r.moveLock(q); err != nil {
+			return pb.Task{}, err
+		}
+		if err := r.checkParent(q, task.Id, task.ParentId); err != nil {
+			return pb.Task{}, err
+		}
+	}
 
-	if i, _ := result.RowsAffected(); i == 0 {
-		return pb.Task{}, sql.ErrNoRows
+	if task.Version != 0 {
+		where.add("version = $%d", task.Version)
+	}
+	var sets []string
+	for _, field := range fields {
+		columns, ok := fieldColumns[field]
+		if !ok {
+			return pb.Task{}, &repo.FieldError{Field: "update_mask", Description: fmt.Sprintf("unknown task field %q", field)}
+		}
+		for _, column := range columns {
+			sets = append(sets, fmt.Sprintf("%s=%s", column, where.placeholder(values[column])))
+		}
 	}
+	now := r.now()
+	query := fmt.Sprintf(`UPDATE todos SET %s, updated_at=%s, version=version+1 %s RETURNING %s`,
+		strings.Join(sets, ", "), where.placeholder(now), where, taskColumns)
 
-	task, err = r.Get(task.Id)
+	updated, err := scanTask(q.QueryRow(query, where.args...))
+	if err == sql.ErrNoRows {
+		return pb.Task{}, r.missingOrConflict(q, task.Id)
+	}
 	if err != nil {
 		return pb.Task{}, err
 	}
+	if err := writeHistory(q, r.actor, now, historyEntry{action: repo.ActionUpdate, before: before, after: updated}); err != nil {
+		return pb.Task{}, err
+	}
 
-	return task, nil
+	return updated, writeEvent(q, now, updated, repo.ChangeEvents(before.Status, updated)...)
 }
 
-func (r *taskRepo) Delete(id string) error {
-	result, err := r.db.Exec(`UPDATE todos SET deleted_at=$1 WHERE id=$2 and deleted_at is null`, time.Now(), id)
+func (r *taskRepo) deleteTask(q querier, id string, version int64) error {
+	where := r.where(liveTasks)
+	where.add("id = $%d", id)
+	if version != 0 {
+		where.add("version = $%d", version)
+	}
+
+	now := r.now()
+	deleted, err := scanTask(q.QueryRow(fmt.Sprintf(`UPDATE todos SET deleted_at=%s, version=version+1 %s RETURNING %s`,
+		where.placeholder(now), where, taskColumns), where.args...))
+	if err == sql.ErrNoRows {
+		return r.missingOrConflict(q, id)
+	}
+	if err != nil {
+		return err
+	}
+	subtasks, err := deleteSubtasks(q, deleted.Id, now)
 	if err != nil {
 		return err
 	}
 
-	if i, _ := result.RowsAffected(); i == 0 {
-		return sql.ErrNoRows
+	entries := []historyEntry{{action: repo.ActionDelete, before: deleted, after: deleted}}
+	events := []repo.Event{{Type: repo.EventTaskDeleted, Task: deleted}}
+	for _, subtask := range subtasks {
+		entries = append(entries, historyEntry{action: repo.ActionDelete, before: subtask, after: subtask})
+		events = append(events, repo.Event{Type: repo.EventTaskDeleted, Task: subtask})
+	}
+	if err := writeHistory(q, r.actor, now, entries...); err != nil {
+		return err
 	}
 
-	return nil
+	return writeEvents(q, now, events)
 }
 
-func (r *taskRepo) ListOverdue(deadline string, page, limit int64) ([]*pb.Task, int64, error) {
-	offset := (page - 1) * limit
-	time, err := time.Parse("2006-01-02", deadline)
+// missingOrConflict explains why a conditional write touched no rows: either the task
+// is gone or not the repository's to see (repo.ErrNotFound), or it was changed by someone
+// else (repo.ErrConflict).
+func (r *taskRepo) missingOrConflict(q querier, id string) error {
+	where := r.where(liveTasks)
+	where.add("id = $%d", id)
+	var exists bool
+	err := q.QueryRow(`SELECT exists(SELECT 1 FROM todos `+where.String()+`)`, where.args...).Scan(&exists)
 	if err != nil {
-		return nil, 0, err
+		return wrapError(err)
 	}
-	rows, err := r.db.Queryx(
-		`SELECT id, assignee, title, summary, deadline, status, created_at FROM todos WHERE deadline < $1 and deleted_at is null LIMIT $2 OFFSET $3 `, time, limit, offset)
-	if err != nil {
-		return nil, 0, err
+
+	if !exists {
+		return repo.ErrNotFound
 	}
-	if err = rows.Err(); err != nil {
-		return nil, 0, err
+
+	return repo.ErrConflict
+}
+
+// scanner is implemented by *sql.Row and *sqlx.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanTask reads one row of taskColumns.
+func scanTask(row scanner) (pb.Task, error) {
+	var (
+		task                           pb.Task
+		deadline, createdAt, updatedAt sql.NullTime
+		seriesID, projectID, parentID  sql.NullString
+	)
+	err := row.Scan(&task.Id, &task.Assignee, &task.Title, &task.Summary, &deadline, &task.Status, &createdAt, &updatedAt, &task.Version,
+		&task.TimeZone, &task.Recurrence, &seriesID, &task.Occurrence, &task.Owner, &task.WorkspaceId, &projectID, &parentID,
+		&task.SubtasksTotal, &task.SubtasksDone)
+	if err != nil {
+		return pb.Task{}, err
 	}
-	defer rows.Close() //nolint:errcheck
 
+	task.SeriesId, task.ProjectId, task.ParentId = seriesID.String, projectID.String, parentID.String
+
+	task.Deadline, task.DeadlineTime = timestamp(deadline)
+	task.CreatedAt, task.CreatedTime = timestamp(createdAt)
+	task.UpdatedAt, task.UpdatedTime = timestamp(updatedAt)
+	return task, nil
+}
+
+// scanListedTask reads one row of listColumns followed by extra.
+func scanListedTask(row scanner, extra ...interface{}) (*pb.Task, error) {
 	var (
-		tasks []*pb.Task
-		count int64
+		task                           pb.Task
+		deadline, createdAt, deletedAt sql.NullTime
+		seriesID, projectID, parentID  sql.NullString
 	)
+	dest := append([]interface{}{&task.Id, &task.Assignee, &task.Title, &task.Summary, &deadline, &task.Status, &createdAt, &task.Version, &deletedAt,
+		&task.TimeZone, &task.Recurrence, &seriesID, &task.Occurrence, &task.Owner, &task.WorkspaceId, &projectID, &parentID,
+		&task.SubtasksTotal, &task.SubtasksDone}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
 
-	for rows.Next() {
-		var task pb.Task
-		err = rows.Scan(&task.Id, &task.Assignee, &task.Title, &task.Summary, &task.Deadline, &task.Status, &task.CreatedAt)
+	task.SeriesId, task.ProjectId, task.ParentId = seriesID.String, projectID.String, parentID.String
+
+	task.Deadline, task.DeadlineTime = timestamp(deadline)
+	task.CreatedAt, task.CreatedTime = timestamp(createdAt)
+	task.DeletedAt, task.DeletedTime = timestamp(deletedAt)
+	return &task, nil
+}
+
+// timestamp renders t in UTC both ways a task carries its times.
+func timestamp(t sql.NullTime) (string, *types.Timestamp) {
+	if !t.Valid {
+		return "", nil
+	}
+
+	utc := t.Time.UTC()
+	ts, err := types.TimestampProto(utc)
+	if err != nil {
+		return utc.Format(time.RFC3339Nano), nil
+	}
+
+	return utc.Format(time.RFC3339Nano), ts
+}
+
+// deadlineValue is what gets written to todos.deadline: deadline_time when it is set,
+// otherwise the Deadline string for Postgres to parse.
+func deadlineValue(task pb.Task) interface{} {
+	if task.DeadlineTime != nil {
+		if t, err := types.TimestampFromProto(task.DeadlineTime); err == nil {
+			return t
+		}
+	}
+
+	return nullable(task.Deadline)
+}
+
+// nullable stores empty strings as NULL.
+func nullable(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func (r *taskRepo) ListOverdue(req pb.ByDeadlineReq) (pb.ListResp, error) {
+	deadline, err := repo.ParseOverdueCutoff(req.Deadline)
+	if err != nil {
+		return pb.ListResp{}, err
+	}
+
+	where := r.where(liveTasks)
+	where.add("deadline < $%d", deadline)
+	if req.PageToken != "" || req.Page == 0 {
+		return r.listByCursor(where, req.PageToken, false, req.Limit)
+	}
+
+	return r.listByOffset(where, "ORDER BY created_at, id", req.Page, req.Limit)
+}
+
+// listByOffset returns one page located with LIMIT/OFFSET together with the total count of matching rows.
+func (r *taskRepo) listByOffset(where *whereBuilder, order string, page, limit int64) (pb.ListResp, error) {
+	countArgs := where.args
+	limitArg, offsetArg := where.placeholder(limit), where.placeholder((page-1)*limit)
+	tasks, err := r.selectTasks(
+		fmt.Sprintf(`SELECT %s FROM todos %s %s LIMIT %s OFFSET %s`, listColumns, where, order, limitArg, offsetArg),
+		where.args...)
+	if err != nil {
+		return pb.ListResp{}, wrapError(err)
+	}
+
+	var count int64
+	err = r.db.QueryRow(`SELECT count(*) FROM todos `+where.String(), countArgs...).Scan(&count)
+	if err != nil {
+		return pb.ListResp{}, wrapError(err)
+	}
+
+	return pb.ListResp{
+		Tasks: tasks,
+		Count: count,
+	}, nil
+}
+
+// listByCursor returns the page that follows token in (created_at, id) order. One extra row
+// is fetched to find out whether a next page exists, so no count query is needed.
+func (r *taskRepo) listByCursor(where *whereBuilder, token string, desc bool, limit int64) (pb.ListResp, error) {
+	if limit <= 0 {
+		return pb.ListResp{}, &repo.FieldError{Field: "limit", Description: "must be positive when paging with tokens"}
+	}
+
+	direction, cmp := "ASC", ">"
+	if desc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if token != "" {
+		after, err := repo.DecodePageToken(token)
 		if err != nil {
-			return nil, 0, err
+			return pb.ListResp{}, wrapError(err)
 		}
-		tasks = append(tasks, &task)
+		where.addRaw(fmt.Sprintf("(created_at, id) %s (%s::timestamptz, %s::uuid)",
+			cmp, where.placeholder(after.CreatedAt), where.placeholder(after.ID)))
 	}
 
-	err = r.db.QueryRow(`SELECT count(id) FROM todos WHERE deadline < $1 and deleted_at is null`, time).Scan(&count)
+	limitArg := where.placeholder(limit + 1)
+	tasks, err := r.selectTasks(
+		fmt.Sprintf(`SELECT %s FROM todos %s ORDER BY created_at %s, id %s LIMIT %s`, listColumns, where, direction, direction, limitArg),
+		where.args...)
 	if err != nil {
-		return nil, 0, err
+		return pb.ListResp{}, wrapError(err)
+	}
+
+	var resp pb.ListResp
+	if int64(len(tasks)) > limit {
+		tasks = tasks[:limit]
+		last := tasks[len(tasks)-1]
+		createdAt, err := types.TimestampFromProto(last.CreatedTime)
+		if err != nil {
+			return pb.ListResp{}, wrapError(err)
+		}
+		resp.NextPageToken = repo.PageToken{CreatedAt: createdAt, ID: last.Id}.Encode()
+	}
+	resp.Tasks = tasks
+
+	return resp, nil
+}
+
+func (r *taskRepo) selectTasks(query string, args ...interface{}) ([]*pb.Task, error) {
+	rows, err := r.db.Queryx(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var tasks []*pb.Task
+	for rows.Next() {
+		task, err := scanListedTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
 	}
 
-	return tasks, count, nil
+	return tasks, nil
 }
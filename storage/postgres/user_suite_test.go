@@ -5,6 +5,7 @@ import (
 	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
 	"github.com/NafisaTojiboyeva/todo-service/pkg/db"
 	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+	"github.com/NafisaTojiboyeva/todo-service/storage/storagetest"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -34,10 +35,11 @@ func (suite *TaskRepositoryTestSuite) TestTaskCRUD() {
 		Title:    "Test",
 		Summary:  "Just testing create function",
 		Deadline: "2021-12-01",
-		Status:   "Passed",
+		Status:   "done",
 	}
 
-	_ = suite.Repository.Delete(id)
+	_ = suite.Repository.Delete(id, 0)
+	_ = suite.Repository.Purge(id)
 
 	task, err := suite.Repository.Create(task)
 	suite.Nil(err)
@@ -56,17 +58,17 @@ func (suite *TaskRepositoryTestSuite) TestTaskCRUD() {
 	suite.NotNil(getTask)
 	suite.Equal(getTask.Title, updatedTask.Title)
 
-	listTasks, _, err := suite.Repository.List(1, 5)
+	listTasks, err := suite.Repository.List(pb.ListReq{Page: 1, Limit: 5})
 	suite.Nil(err)
-	suite.NotEmpty(listTasks)
-	suite.Equal(task.Title, listTasks[0].Title)
+	suite.NotEmpty(listTasks.Tasks)
+	suite.Equal(task.Title, listTasks.Tasks[0].Title)
 
-	overdueTasks, _, err := suite.Repository.ListOverdue("2021-12-19", 1, 2)
+	overdueTasks, err := suite.Repository.ListOverdue(pb.ByDeadlineReq{Deadline: "2021-12-19", Page: 1, Limit: 2})
 	suite.Nil(err)
-	suite.NotEmpty(overdueTasks)
-	suite.Equal(overdueTasks[0].Deadline, task.Deadline)
+	suite.NotEmpty(overdueTasks.Tasks)
+	suite.Equal(overdueTasks.Tasks[0].Deadline, task.Deadline)
 
-	err = suite.Repository.Delete(id)
+	err = suite.Repository.Delete(id, 0)
 	suite.Nil(err)
 }
 
@@ -77,3 +79,27 @@ func (suite *TaskRepositoryTestSuite) TearDownSuite() {
 func TestTaskRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(TaskRepositoryTestSuite))
 }
+
+func TestTaskRepoConformance(t *testing.T) {
+	suite.Run(t, &storagetest.TaskStorageSuite{Repository: pgRepo})
+}
+
+func TestReminderRepoConformance(t *testing.T) {
+	suite.Run(t, &storagetest.ReminderStorageSuite{Tasks: pgRepo, Reminders: NewReminderRepo(pgRepo.db)})
+}
+
+func TestWebhookRepoConformance(t *testing.T) {
+	suite.Run(t, &storagetest.WebhookStorageSuite{Repository: NewWebhookRepo(pgRepo.db)})
+}
+
+func TestWorkspaceRepoConformance(t *testing.T) {
+	suite.Run(t, &storagetest.WorkspaceStorageSuite{Workspaces: NewWorkspaceRepo(pgRepo.db), Tasks: pgRepo})
+}
+
+func TestProjectRepoConformance(t *testing.T) {
+	suite.Run(t, &storagetest.ProjectStorageSuite{Projects: NewProjectRepo(pgRepo.db), Workspaces: NewWorkspaceRepo(pgRepo.db), Tasks: pgRepo})
+}
+
+func TestOutboxRepoConformance(t *testing.T) {
+	suite.Run(t, &storagetest.OutboxStorageSuite{Tasks: pgRepo, Outbox: NewOutboxRepo(pgRepo.db)})
+}
@@ -4,9 +4,13 @@ import (
 	"errors"
 	"fmt"
 	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/gogo/protobuf/types"
 )
 
 func TestTaskRepo_Create(t *testing.T) {
@@ -24,15 +28,16 @@ func TestTaskRepo_Create(t *testing.T) {
 				Title:    "Test",
 				Summary:  "Just testing create function",
 				Deadline: "2021-12-01",
-				Status:   "Passed",
+				Status:   "done",
 			},
 			want: pb.Task{
 				Assignee:  "Lola",
 				Title:     "Test",
 				Summary:   "Just testing create function",
 				Deadline:  "2021-12-01",
-				Status:    "Passed",
+				Status:    "done",
 				CreatedAt: "2021-12-20",
+				Version:   1,
 			},
 			wantErr: false,
 		},
@@ -44,15 +49,16 @@ func TestTaskRepo_Create(t *testing.T) {
 				Title:    "Test",
 				Summary:  "Just testing create function",
 				Deadline: "01.12.2021",
-				Status:   "Passed",
+				Status:   "done",
 			},
 			want: pb.Task{
 				Assignee:  "Abs",
 				Title:     "Test",
 				Summary:   "Just testing create function",
 				Deadline:  "2021-01-12",
-				Status:    "Passed",
+				Status:    "done",
 				CreatedAt: "2021-12-20",
+				Version:   1,
 			},
 			wantErr: false,
 		},
@@ -64,25 +70,7 @@ func TestTaskRepo_Create(t *testing.T) {
 			if err != nil {
 				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
 			}
-			deadline, err := time.Parse(time.RFC3339, got.GetDeadline())
-			got.Deadline = deadline.Format("2006-01-02")
-			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-			}
-
-			createdAt, err := time.Parse(time.RFC3339, got.GetCreatedAt())
-			got.CreatedAt = createdAt.Format("2006-01-02")
-			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-			}
-
-			if got.UpdatedAt != "" {
-				updatedAt, err := time.Parse(time.RFC3339, got.GetUpdatedAt())
-				got.UpdatedAt = updatedAt.Format("2006-01-02")
-				if err != nil {
-					t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-				}
-			}
+			dates(t, &got)
 
 			got.Id = ""
 			if !reflect.DeepEqual(tc.want, got) {
@@ -114,8 +102,9 @@ func TestTaskRepo_Get(t *testing.T) {
 				Title:     "Test",
 				Summary:   "Just testing create function",
 				Deadline:  "2021-01-12",
-				Status:    "Passed",
+				Status:    "done",
 				CreatedAt: "2021-12-20",
+				Version:   1,
 			},
 			wantErr: false,
 		},
@@ -133,25 +122,7 @@ func TestTaskRepo_Get(t *testing.T) {
 					t.Fatalf("%s: expected: %v, got: %v", tc.name, "no sql rows result", err)
 				}
 			} else {
-				deadline, err := time.Parse(time.RFC3339, got.GetDeadline())
-				got.Deadline = deadline.Format("2006-01-02")
-				if err != nil {
-					t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-				}
-
-				createdAt, err := time.Parse(time.RFC3339, got.GetCreatedAt())
-				got.CreatedAt = createdAt.Format("2006-01-02")
-				if err != nil {
-					t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-				}
-
-				if got.UpdatedAt != "" {
-					updatedAt, err := time.Parse(time.RFC3339, got.GetUpdatedAt())
-					got.UpdatedAt = updatedAt.Format("2006-01-02")
-					if err != nil {
-						t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-					}
-				}
+				dates(t, &got)
 
 				if !reflect.DeepEqual(tc.want, got) {
 					t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
@@ -165,15 +136,13 @@ func TestTaskRepo_Get(t *testing.T) {
 func TestTaskRepo_List(t *testing.T) {
 	tests := []struct {
 		name    string
-		page    int64
-		limit   int64
+		input   pb.ListReq
 		want    []pb.Task
 		wantErr bool
 	}{
 		{
 			name:  "successful",
-			page:  1,
-			limit: 2,
+			input: pb.ListReq{Page: 1, Limit: 2},
 			want: []pb.Task{
 				{
 					Id:        "a7d8c465-8178-4455-9a8c-adb951f758c6",
@@ -181,38 +150,69 @@ func TestTaskRepo_List(t *testing.T) {
 					Title:     "Test",
 					Summary:   "Just testing create function",
 					Deadline:  "2021-12-01",
-					Status:    "Passed",
+					Status:    "done",
+					CreatedAt: "2021-12-20",
+					Version:   1,
+				},
+				{
+					Id:        "e0c28933-ed82-4cbc-ac9c-437bed43200a",
+					Assignee:  "Abs",
+					Title:     "Test",
+					Summary:   "Just testing create function",
+					Deadline:  "2021-01-12",
+					Status:    "done",
 					CreatedAt: "2021-12-20",
+					Version:   1,
 				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "filtered by assignee sorted by deadline",
+			input: pb.ListReq{
+				Page:      1,
+				Limit:     2,
+				Assignee:  "Abs",
+				SortBy:    "deadline",
+				SortOrder: "desc",
+			},
+			want: []pb.Task{
 				{
 					Id:        "e0c28933-ed82-4cbc-ac9c-437bed43200a",
 					Assignee:  "Abs",
 					Title:     "Test",
 					Summary:   "Just testing create function",
 					Deadline:  "2021-01-12",
-					Status:    "Passed",
+					Status:    "done",
 					CreatedAt: "2021-12-20",
+					Version:   1,
 				},
 			},
 			wantErr: false,
 		},
+		{
+			name:    "unsupported sort field",
+			input:   pb.ListReq{Page: 1, Limit: 2, SortBy: "id; drop table todos"},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			gotTasks, count, err := pgRepo.List(tc.page, tc.limit)
+			got, err := pgRepo.List(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("%s: expected error, got: %v", tc.name, got.Tasks)
+				}
+				return
+			}
 			if err != nil {
 				t.Fatalf("got: %v", err)
 			}
+			gotTasks, count := got.Tasks, got.Count
 			fmt.Println(gotTasks)
 			for _, task := range gotTasks {
-				deadline, err := time.Parse(time.RFC3339, task.GetDeadline())
-				task.Deadline = deadline.Format("2006-01-02")
-				createdAt, err := time.Parse(time.RFC3339, task.GetCreatedAt())
-				task.CreatedAt = createdAt.Format("2006-01-02")
-				if err != nil {
-					t.Fatalf("got: %v", err)
-				}
+				dates(t, task)
 			}
 			if !reflect.DeepEqual(tc.want, gotTasks) && count == 2 {
 				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, gotTasks)
@@ -236,7 +236,7 @@ func TestTaskRepo_Update(t *testing.T) {
 				Title:     "Test",
 				Summary:   "Just testing create function",
 				Deadline:  "2021-12-05",
-				Status:    "Passed",
+				Status:    "done",
 				CreatedAt: "2021-12-20",
 			},
 			want: pb.Task{
@@ -245,9 +245,10 @@ func TestTaskRepo_Update(t *testing.T) {
 				Title:     "Test",
 				Summary:   "Just testing create function",
 				Deadline:  "2021-12-05",
-				Status:    "Passed",
+				Status:    "done",
 				CreatedAt: "2021-12-20",
 				UpdatedAt: "2021-12-20",
+				Version:   2,
 			},
 			wantErr: false,
 		},
@@ -259,28 +260,68 @@ func TestTaskRepo_Update(t *testing.T) {
 			if err != nil {
 				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
 			}
-			deadline, err := time.Parse(time.RFC3339, got.GetDeadline())
-			got.Deadline = deadline.Format("2006-01-02")
+			dates(t, &got)
 			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
+				t.Fatalf("got: %v", err)
 			}
-
-			createdAt, err := time.Parse(time.RFC3339, got.GetCreatedAt())
-			got.CreatedAt = createdAt.Format("2006-01-02")
-			if err != nil {
-				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
 			}
+		})
+	}
+}
 
-			if got.UpdatedAt != "" {
-				updatedAt, err := time.Parse(time.RFC3339, got.GetUpdatedAt())
-				got.UpdatedAt = updatedAt.Format("2006-01-02")
-				if err != nil {
-					t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
+func TestTaskRepo_Patch(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   pb.Task
+		fields  []string
+		want    pb.Task
+		wantErr bool
+	}{
+		{
+			name: "only title",
+			input: pb.Task{
+				Id:    "a7d8c465-8178-4455-9a8c-adb951f758c6",
+				Title: "Patched",
+			},
+			fields: []string{"title"},
+			want: pb.Task{
+				Id:       "a7d8c465-8178-4455-9a8c-adb951f758c6",
+				Assignee: "Lola",
+				Title:    "Patched",
+				Summary:  "Just testing create function",
+				Deadline: "2021-12-05",
+				Status:   "done",
+				Version:  3,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown field",
+			input: pb.Task{
+				Id: "a7d8c465-8178-4455-9a8c-adb951f758c6",
+			},
+			fields:  []string{"created_at"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := pgRepo.Patch(tc.input, tc.fields)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("%s: expected error, got: %v", tc.name, got)
 				}
+				return
 			}
 			if err != nil {
-				t.Fatalf("got: %v", err)
+				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
 			}
+
+			dates(t, &got)
+			got.CreatedAt, got.UpdatedAt = "", ""
 			if !reflect.DeepEqual(tc.want, got) {
 				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, got)
 			}
@@ -288,6 +329,34 @@ func TestTaskRepo_Update(t *testing.T) {
 	}
 }
 
+func TestTaskRepo_VersionConflict(t *testing.T) {
+	task, err := pgRepo.Get("e0c28933-ed82-4cbc-ac9c-437bed43200a")
+	if err != nil {
+		t.Fatalf("got: %v", err)
+	}
+
+	stale := task.Version
+	task.Title = "Changed by first client"
+	if _, err = pgRepo.Update(task); err != nil {
+		t.Fatalf("got: %v", err)
+	}
+
+	task.Title = "Changed by second client"
+	task.Version = stale
+	if _, err = pgRepo.Update(task); !errors.Is(err, repo.ErrConflict) {
+		t.Fatalf("update: expected: %v, got: %v", repo.ErrConflict, err)
+	}
+	if _, err = pgRepo.Patch(task, []string{"title"}); !errors.Is(err, repo.ErrConflict) {
+		t.Fatalf("patch: expected: %v, got: %v", repo.ErrConflict, err)
+	}
+	if err = pgRepo.Delete(task.Id, stale); !errors.Is(err, repo.ErrConflict) {
+		t.Fatalf("delete: expected: %v, got: %v", repo.ErrConflict, err)
+	}
+	if err = pgRepo.Delete("24465fe0-9ea1-45ce-8a7a-79c63972efe8", stale); !errors.Is(err, repo.ErrNotFound) {
+		t.Fatalf("delete missing: expected: %v, got: %v", repo.ErrNotFound, err)
+	}
+}
+
 func TestTaskRepo_Delete(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -304,14 +373,14 @@ func TestTaskRepo_Delete(t *testing.T) {
 		{
 			name:    "delete not existing id",
 			input:   "24465fe0-9ea1-45ce-8a7a-79c63972efe8",
-			want:    errors.New("sql: no rows in result set"),
+			want:    repo.ErrNotFound,
 			wantErr: true,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			err := pgRepo.Delete(tc.input)
+			err := pgRepo.Delete(tc.input, 0)
 			if err == nil {
 				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.want, err)
 			}
@@ -344,9 +413,10 @@ func TestTaskRepo_ListOverdue(t *testing.T) {
 					Title:     "Test",
 					Summary:   "Just testing create function",
 					Deadline:  "2021-12-05",
-					Status:    "Passed",
+					Status:    "done",
 					CreatedAt: "2021-12-20",
 					UpdatedAt: "2021-12-20",
+					Version:   3,
 				},
 			},
 			wantErr: false,
@@ -355,19 +425,14 @@ func TestTaskRepo_ListOverdue(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			gotTasks, count, err := pgRepo.ListOverdue(tc.inputDeadline, tc.inputPage, tc.inputLimit)
+			got, err := pgRepo.ListOverdue(pb.ByDeadlineReq{Deadline: tc.inputDeadline, Page: tc.inputPage, Limit: tc.inputLimit})
 			if err != nil {
 				t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
 			}
+			gotTasks, count := got.Tasks, got.Count
 
 			for _, task := range gotTasks {
-				deadline, err := time.Parse(time.RFC3339, task.GetDeadline())
-				task.Deadline = deadline.Format("2006-01-02")
-				createdAt, err := time.Parse(time.RFC3339, task.GetCreatedAt())
-				task.CreatedAt = createdAt.Format("2006-01-02")
-				if err != nil {
-					t.Fatalf("%s: expected: %v got: %v", tc.name, tc.wantErr, err)
-				}
+				dates(t, task)
 			}
 			if !reflect.DeepEqual(tc.want, gotTasks) && count == 4 {
 				t.Fatalf("%s: expected: %v, got: %v", tc.name, tc.want, gotTasks)
@@ -375,3 +440,274 @@ func TestTaskRepo_ListOverdue(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskRepo_ListByPageToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   pb.ListReq
+		wantErr bool
+	}{
+		{
+			name:  "walk all pages",
+			input: pb.ListReq{Limit: 1},
+		},
+		{
+			name:  "walk all pages descending",
+			input: pb.ListReq{Limit: 1, SortOrder: "desc"},
+		},
+		{
+			name:    "malformed token",
+			input:   pb.ListReq{Limit: 1, PageToken: "not a token"},
+			wantErr: true,
+		},
+		{
+			name:    "sorting by other fields",
+			input:   pb.ListReq{Limit: 1, SortBy: "deadline"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			all, err := pgRepo.List(pb.ListReq{Page: 1, Limit: 1000})
+			if err != nil {
+				t.Fatalf("got: %v", err)
+			}
+
+			seen := map[string]bool{}
+			req := tc.input
+			for {
+				got, err := pgRepo.List(req)
+				if tc.wantErr {
+					if err == nil {
+						t.Fatalf("%s: expected error, got: %v", tc.name, got.Tasks)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("%s: got: %v", tc.name, err)
+				}
+				for _, task := range got.Tasks {
+					if seen[task.Id] {
+						t.Fatalf("%s: task %s returned twice", tc.name, task.Id)
+					}
+					seen[task.Id] = true
+				}
+				if got.NextPageToken == "" {
+					break
+				}
+				req.PageToken = got.NextPageToken
+			}
+
+			if int64(len(seen)) != all.Count {
+				t.Fatalf("%s: expected: %d tasks, got: %d", tc.name, all.Count, len(seen))
+			}
+		})
+	}
+}
+
+func TestTaskRepo_RestoreAndPurge(t *testing.T) {
+	id := "5b0b3e8e-8c43-4a50-a2f4-53f1d9b0f0a1"
+	_ = pgRepo.Delete(id, 0)
+	_ = pgRepo.Purge(id)
+
+	task, err := pgRepo.Create(pb.Task{Id: id, Assignee: "Lola", Title: "Restore me", Deadline: "2021-12-01", Status: "todo"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err = pgRepo.Delete(task.Id, 0); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	deleted, err := pgRepo.ListDeleted(pb.ListReq{Page: 1, Limit: 1000, Assignee: "Lola"})
+	if err != nil {
+		t.Fatalf("list deleted: %v", err)
+	}
+	found := false
+	for _, d := range deleted.Tasks {
+		if d.Id == id {
+			found = d.DeletedAt != ""
+		}
+	}
+	if !found {
+		t.Fatalf("list deleted: expected %s with deleted_at, got: %v", id, deleted.Tasks)
+	}
+
+	restored, err := pgRepo.Restore(id)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if restored.Title != task.Title || restored.Version <= task.Version {
+		t.Fatalf("restore: expected: %v, got: %v", task, restored)
+	}
+	if _, err = pgRepo.Restore(id); !errors.Is(err, repo.ErrNotFound) {
+		t.Fatalf("restore live task: expected: %v, got: %v", repo.ErrNotFound, err)
+	}
+	if err = pgRepo.Purge(id); !errors.Is(err, repo.ErrNotFound) {
+		t.Fatalf("purge live task: expected: %v, got: %v", repo.ErrNotFound, err)
+	}
+
+	if err = pgRepo.Delete(id, 0); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err = pgRepo.Purge(id); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if _, err = pgRepo.Restore(id); !errors.Is(err, repo.ErrNotFound) {
+		t.Fatalf("restore purged task: expected: %v, got: %v", repo.ErrNotFound, err)
+	}
+
+	if _, err = pgRepo.PurgeDeletedBefore(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("purge before: %v", err)
+	}
+}
+
+func TestTaskRepo_Batch(t *testing.T) {
+	ids := []string{"0d7d6a3e-3b0c-4c38-9a51-2c6f0e5b7a01", "0d7d6a3e-3b0c-4c38-9a51-2c6f0e5b7a02"}
+	for _, id := range ids {
+		_ = pgRepo.Delete(id, 0)
+		_ = pgRepo.Purge(id)
+	}
+
+	tasks := []pb.Task{
+		{Id: ids[0], Assignee: "Lola", Title: "Batch one", Deadline: "2021-12-01", Status: "todo"},
+		{Id: ids[1], Assignee: "Lola", Title: "Batch two", Status: "todo"},
+	}
+	created, err := pgRepo.BatchCreate(tasks, true)
+	if err != nil {
+		t.Fatalf("batch create: %v", err)
+	}
+	for i, r := range created {
+		if r.Err != nil || r.Task.Id != ids[i] || r.Task.Version != 1 {
+			t.Fatalf("batch create: item %d: expected: %s, got: %v, %v", i, ids[i], r.Task, r.Err)
+		}
+	}
+
+	_, err = pgRepo.BatchCreate(tasks[:1], true)
+	var itemErr *repo.BatchItemError
+	if !errors.As(err, &itemErr) || !errors.Is(err, repo.ErrConflict) {
+		t.Fatalf("batch create duplicate: expected: %v, got: %v", repo.ErrConflict, err)
+	}
+
+	patches := []repo.TaskPatch{
+		{Task: pb.Task{Id: ids[0], Title: "Batch one, renamed", Version: 1}, Fields: []string{"title"}},
+		{Task: pb.Task{Id: ids[1], Title: "Stale", Version: 7}, Fields: []string{"title"}},
+	}
+	updated, err := pgRepo.BatchUpdate(patches, false)
+	if err != nil {
+		t.Fatalf("batch update: %v", err)
+	}
+	if updated[0].Err != nil || updated[0].Task.Title != "Batch one, renamed" {
+		t.Fatalf("batch update: item 0: got: %v, %v", updated[0].Task, updated[0].Err)
+	}
+	if !errors.Is(updated[1].Err, repo.ErrConflict) {
+		t.Fatalf("batch update: item 1: expected: %v, got: %v", repo.ErrConflict, updated[1].Err)
+	}
+
+	if _, err = pgRepo.BatchUpdate(patches, true); !errors.As(err, &itemErr) {
+		t.Fatalf("batch update all-or-nothing: expected a batch item error, got: %v", err)
+	}
+	got, err := pgRepo.Get(ids[0])
+	if err != nil || got.Version != 2 {
+		t.Fatalf("batch update all-or-nothing: expected version 2 to be kept, got: %v, %v", got, err)
+	}
+
+	found, err := pgRepo.GetMany(ids)
+	if err != nil || len(found) != 2 {
+		t.Fatalf("get many: expected 2 tasks, got: %v, %v", found, err)
+	}
+
+	deleted, err := pgRepo.BatchDelete([]pb.ByIdReq{{Id: ids[0]}, {Id: ids[1]}, {Id: ids[1]}}, false)
+	if err != nil {
+		t.Fatalf("batch delete: %v", err)
+	}
+	if deleted[0].Err != nil || deleted[1].Err != nil || !errors.Is(deleted[2].Err, repo.ErrNotFound) {
+		t.Fatalf("batch delete: expected the repeated id to be not found, got: %v", deleted)
+	}
+}
+
+func TestTaskRepo_Clock(t *testing.T) {
+	ids := []string{"0d7d6a3e-3b0c-4c38-9a51-2c6f0e5b7a03", "0d7d6a3e-3b0c-4c38-9a51-2c6f0e5b7a04"}
+	for _, id := range ids {
+		_ = pgRepo.Delete(id, 0)
+		_ = pgRepo.Purge(id)
+	}
+
+	clocked := NewTaskRepo(pgRepo.db)
+	clocked.SetClock(func() time.Time { return time.Date(2021, 12, 20, 9, 0, 0, 0, time.UTC) })
+	created, err := clocked.Create(pb.Task{Id: ids[0], Assignee: "Lola", Title: "Clocked", Status: "todo"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	batch, err := clocked.BatchCreate([]pb.Task{{Id: ids[1], Assignee: "Lola", Title: "Clocked in a batch", Status: "todo"}}, true)
+	if err != nil || batch[0].Err != nil {
+		t.Fatalf("batch create: %v, %v", err, batch)
+	}
+
+	for _, task := range []pb.Task{created, batch[0].Task} {
+		dates(t, &task)
+		if task.CreatedAt != "2021-12-20" {
+			t.Errorf("%s: expected to be created on the day of the clock, got: %s", task.Id, task.CreatedAt)
+		}
+
+		// History and the outbox are written along with the task, at the same time.
+		history, err := clocked.History(pb.TaskHistoryReq{TaskId: task.Id, Page: 1, Limit: 1})
+		if err != nil || len(history.Changes) != 1 {
+			t.Fatalf("%s: history: %v, %v", task.Id, history.Changes, err)
+		}
+		changed, err := types.TimestampFromProto(history.Changes[0].ChangedTime)
+		if err != nil || !changed.Equal(clocked.now()) {
+			t.Errorf("%s: expected the change at the time of the clock, got: %v, %v", task.Id, changed, err)
+		}
+		var occurred time.Time
+		err = pgRepo.db.QueryRow(`SELECT created_at FROM outbox WHERE task_id = $1 ORDER BY seq DESC LIMIT 1`, task.Id).Scan(&occurred)
+		if err != nil || !occurred.Equal(clocked.now()) {
+			t.Errorf("%s: expected the event at the time of the clock, got: %v, %v", task.Id, occurred, err)
+		}
+	}
+}
+
+func TestTaskRepo_Search(t *testing.T) {
+	ids := []string{"7c1e2f4a-9d3b-4e51-8a6c-0b2d4f6e8a01", "7c1e2f4a-9d3b-4e51-8a6c-0b2d4f6e8a02", "7c1e2f4a-9d3b-4e51-8a6c-0b2d4f6e8a03"}
+	tasks := []pb.Task{
+		{Id: ids[0], Assignee: "Search", Title: "Write release notes", Summary: "Cover the search feature", Status: "todo"},
+		{Id: ids[1], Assignee: "Search", Title: "Review budget", Summary: "Check release costs", Status: "todo"},
+		{Id: ids[2], Assignee: "Search", Title: "Water the plants", Status: "todo"},
+	}
+	for _, task := range tasks {
+		_ = pgRepo.Delete(task.Id, 0)
+		_ = pgRepo.Purge(task.Id)
+		if _, err := pgRepo.Create(task); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	got, err := pgRepo.Search(pb.SearchReq{Query: "release", Assignee: "Search", Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if got.Count != 2 || len(got.Results) != 2 {
+		t.Fatalf("search: expected 2 results, got: %v", got)
+	}
+	// a title match outranks a summary match
+	if got.Results[0].Task.Id != ids[0] || !strings.Contains(got.Results[0].TitleSnippet, "<b>release</b>") {
+		t.Fatalf("search: expected %s first with a highlighted title, got: %v", ids[0], got.Results[0])
+	}
+
+	first, err := pgRepo.Search(pb.SearchReq{Query: "release", Assignee: "Search", Limit: 1})
+	if err != nil || len(first.Results) != 1 || first.NextPageToken == "" {
+		t.Fatalf("search first page: got: %v, %v", first, err)
+	}
+	second, err := pgRepo.Search(pb.SearchReq{Query: "release", Assignee: "Search", Limit: 1, PageToken: first.NextPageToken})
+	if err != nil || len(second.Results) != 1 || second.Results[0].Task.Id != ids[1] || second.NextPageToken != "" {
+		t.Fatalf("search second page: expected only %s, got: %v, %v", ids[1], second, err)
+	}
+
+	if err = pgRepo.Delete(ids[0], 0); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	got, err = pgRepo.Search(pb.SearchReq{Query: "release", Assignee: "Search", Page: 1, Limit: 10})
+	if err != nil || got.Count != 1 {
+		t.Fatalf("search after delete: expected 1 result, got: %v, %v", got, err)
+	}
+}
@@ -0,0 +1,222 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// webhookColumns are the webhooks columns scanWebhook scans.
+const webhookColumns = "id, url, events, secret, disabled, created_at, updated_at"
+
+// deliveryColumns are the webhook_deliveries columns scanDelivery scans.
+const deliveryColumns = "id, webhook_id, event_id, event, attempt, status_code, error, success, created_at"
+
+type webhookRepo struct {
+	db *sqlx.DB
+}
+
+// NewWebhookRepo ...
+func NewWebhookRepo(db *sqlx.DB) *webhookRepo {
+	return &webhookRepo{db: db}
+}
+
+func (r *webhookRepo) Create(hook pb.Webhook) (pb.Webhook, error) {
+	now := time.Now()
+	hook, err := scanWebhook(r.db.QueryRow(`
+		INSERT INTO webhooks(id, url, events, secret, disabled, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING `+webhookColumns,
+		hook.Id, hook.Url, pq.Array(hook.Events), hook.Secret, hook.Disabled, now, now))
+	if err != nil {
+		return pb.Webhook{}, wrapError(err)
+	}
+
+	return hook, nil
+}
+
+func (r *webhookRepo) Get(id string) (pb.Webhook, error) {
+	hook, err := scanWebhook(r.db.QueryRow(`SELECT `+webhookColumns+` FROM webhooks WHERE id=$1`, id))
+	if err != nil {
+		return pb.Webhook{}, wrapError(err)
+	}
+
+	return hook, nil
+}
+
+func (r *webhookRepo) List(req pb.ListWebhooksReq) (pb.ListWebhooksResp, error) {
+	rows, err := r.db.Queryx(`SELECT `+webhookColumns+` FROM webhooks ORDER BY created_at, id LIMIT $1 OFFSET $2`,
+		req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return pb.ListWebhooksResp{}, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var resp pb.ListWebhooksResp
+	for rows.Next() {
+		hook, err := scanWebhook(rows)
+		if err != nil {
+			return pb.ListWebhooksResp{}, wrapError(err)
+		}
+		resp.Webhooks = append(resp.Webhooks, &hook)
+	}
+	if err := rows.Err(); err != nil {
+		return pb.ListWebhooksResp{}, wrapError(err)
+	}
+
+	err = r.db.QueryRow(`SELECT count(*) FROM webhooks`).Scan(&resp.Count)
+	if err != nil {
+		return pb.ListWebhooksResp{}, wrapError(err)
+	}
+
+	return resp, nil
+}
+
+func (r *webhookRepo) Update(hook pb.Webhook) (pb.Webhook, error) {
+	hook, err := scanWebhook(r.db.QueryRow(`
+		UPDATE webhooks SET url=$1, events=$2, disabled=$3, secret=COALESCE(NULLIF($4, ''), secret), updated_at=$5
+		WHERE id=$6 RETURNING `+webhookColumns,
+		hook.Url, pq.Array(hook.Events), hook.Disabled, hook.Secret, time.Now(), hook.Id))
+	if err != nil {
+		return pb.Webhook{}, wrapError(err)
+	}
+
+	return hook, nil
+}
+
+func (r *webhookRepo) Delete(id string) error {
+	result, err := r.db.Exec(`DELETE FROM webhooks WHERE id=$1`, id)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return wrapError(err)
+	}
+	if deleted == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) Subscribers(event string) ([]pb.Webhook, error) {
+	rows, err := r.db.Queryx(`SELECT `+webhookColumns+` FROM webhooks
+		WHERE not disabled and $1 = ANY(events) ORDER BY created_at, id`, event)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var hooks []pb.Webhook
+	for rows.Next() {
+		hook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, wrapError(err)
+		}
+		hooks = append(hooks, hook)
+	}
+
+	return hooks, wrapError(rows.Err())
+}
+
+func (r *webhookRepo) RecordDelivery(d pb.WebhookDelivery) error {
+	_, err := r.db.Exec(`
+		INSERT INTO webhook_deliveries(id, webhook_id, event_id, event, attempt, status_code, error, success, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		d.Id, d.WebhookId, d.EventId, d.Event, d.Attempt, d.StatusCode, d.Error, d.Success, time.Now())
+	return wrapError(err)
+}
+
+func (r *webhookRepo) ListDeliveries(req pb.ListWebhookDeliveriesReq) (pb.ListWebhookDeliveriesResp, error) {
+	rows, err := r.db.Queryx(`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE webhook_id=$1
+		ORDER BY created_at DESC, attempt DESC, id LIMIT $2 OFFSET $3`,
+		req.WebhookId, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return pb.ListWebhookDeliveriesResp{}, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var resp pb.ListWebhookDeliveriesResp
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return pb.ListWebhookDeliveriesResp{}, wrapError(err)
+		}
+		resp.Deliveries = append(resp.Deliveries, &d)
+	}
+	if err := rows.Err(); err != nil {
+		return pb.ListWebhookDeliveriesResp{}, wrapError(err)
+	}
+
+	err = r.db.QueryRow(`SELECT count(*) FROM webhook_deliveries WHERE webhook_id=$1`, req.WebhookId).Scan(&resp.Count)
+	if err != nil {
+		return pb.ListWebhookDeliveriesResp{}, wrapError(err)
+	}
+
+	return resp, nil
+}
+
+func (r *webhookRepo) AddDeadLetter(d repo.DeadLetter) error {
+	_, err := r.db.Exec(`
+		INSERT INTO webhook_dead_letters(id, webhook_id, event_id, event, payload, attempts, last_error, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		d.ID, d.WebhookID, d.EventID, d.Event, string(d.Payload), d.Attempts, d.LastError, time.Now())
+	return wrapError(err)
+}
+
+func (r *webhookRepo) ListDeadLetters(webhookID string) ([]repo.DeadLetter, error) {
+	rows, err := r.db.Queryx(`SELECT id, webhook_id, event_id, event, payload, attempts, last_error, created_at
+		FROM webhook_dead_letters WHERE webhook_id=$1 ORDER BY created_at, id`, webhookID)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var letters []repo.DeadLetter
+	for rows.Next() {
+		var d repo.DeadLetter
+		err := rows.Scan(&d.ID, &d.WebhookID, &d.EventID, &d.Event, &d.Payload, &d.Attempts, &d.LastError, &d.CreatedAt)
+		if err != nil {
+			return nil, wrapError(err)
+		}
+		d.CreatedAt = d.CreatedAt.UTC()
+		letters = append(letters, d)
+	}
+
+	return letters, wrapError(rows.Err())
+}
+
+func scanWebhook(row scanner) (pb.Webhook, error) {
+	var (
+		hook                 pb.Webhook
+		createdAt, updatedAt sql.NullTime
+	)
+	err := row.Scan(&hook.Id, &hook.Url, pq.Array(&hook.Events), &hook.Secret, &hook.Disabled, &createdAt, &updatedAt)
+	if err != nil {
+		return pb.Webhook{}, err
+	}
+
+	_, hook.CreatedTime = timestamp(createdAt)
+	_, hook.UpdatedTime = timestamp(updatedAt)
+	return hook, nil
+}
+
+func scanDelivery(row scanner) (pb.WebhookDelivery, error) {
+	var (
+		d         pb.WebhookDelivery
+		createdAt sql.NullTime
+	)
+	err := row.Scan(&d.Id, &d.WebhookId, &d.EventId, &d.Event, &d.Attempt, &d.StatusCode, &d.Error, &d.Success, &createdAt)
+	if err != nil {
+		return pb.WebhookDelivery{}, err
+	}
+
+	_, d.CreatedTime = timestamp(createdAt)
+	return d, nil
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// workspaceColumns are the workspaces columns scanWorkspace scans.
+const workspaceColumns = "id, name, created_at, updated_at"
+
+type workspaceRepo struct {
+	db *sqlx.DB
+}
+
+// NewWorkspaceRepo ...
+func NewWorkspaceRepo(db *sqlx.DB) *workspaceRepo {
+	return &workspaceRepo{db: db}
+}
+
+func (r *workspaceRepo) Create(workspace pb.Workspace) (pb.Workspace, error) {
+	now := time.Now()
+	workspace, err := scanWorkspace(r.db.QueryRow(`
+		INSERT INTO workspaces(id, name, created_at, updated_at) VALUES ($1, $2, $3, $4) RETURNING `+workspaceColumns,
+		workspace.Id, workspace.Name, now, now))
+	if err != nil {
+		return pb.Workspace{}, wrapError(err)
+	}
+
+	return workspace, nil
+}
+
+func (r *workspaceRepo) Get(id string) (pb.Workspace, error) {
+	workspace, err := scanWorkspace(r.db.QueryRow(`SELECT `+workspaceColumns+` FROM workspaces WHERE id=$1`, id))
+	if err != nil {
+		return pb.Workspace{}, wrapError(err)
+	}
+
+	return workspace, nil
+}
+
+func (r *workspaceRepo) List(req pb.ListWorkspacesReq) (pb.ListWorkspacesResp, error) {
+	rows, err := r.db.Queryx(`SELECT `+workspaceColumns+` FROM workspaces ORDER BY created_at, id LIMIT $1 OFFSET $2`,
+		req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return pb.ListWorkspacesResp{}, wrapError(err)
+	}
+	defer rows.Close() // nolint:errcheck
+
+	var resp pb.ListWorkspacesResp
+	for rows.Next() {
+		workspace, err := scanWorkspace(rows)
+		if err != nil {
+			return pb.ListWorkspacesResp{}, wrapError(err)
+		}
+		resp.Workspaces = append(resp.Workspaces, &workspace)
+	}
+	if err := rows.Err(); err != nil {
+		return pb.ListWorkspacesResp{}, wrapError(err)
+	}
+
+	err = r.db.QueryRow(`SELECT count(*) FROM workspaces`).Scan(&resp.Count)
+	if err != nil {
+		return pb.ListWorkspacesResp{}, wrapError(err)
+	}
+
+	return resp, nil
+}
+
+func (r *workspaceRepo) Update(workspace pb.Workspace) (pb.Workspace, error) {
+	workspace, err := scanWorkspace(r.db.QueryRow(`
+		UPDATE workspaces SET name=$1, updated_at=$2 WHERE id=$3 RETURNING `+workspaceColumns,
+		workspace.Name, time.Now(), workspace.Id))
+	if err != nil {
+		return pb.Workspace{}, wrapError(err)
+	}
+
+	return workspace, nil
+}
+
+func (r *workspaceRepo) Delete(id string) error {
+	result, err := r.db.Exec(`DELETE FROM workspaces WHERE id=$1`, id)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		return repo.ErrInUse
+	}
+	if err != nil {
+		return wrapError(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return wrapError(err)
+	}
+	if deleted == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanWorkspace(row scanner) (pb.Workspace, error) {
+	var (
+		workspace            pb.Workspace
+		createdAt, updatedAt sql.NullTime
+	)
+	err := row.Scan(&workspace.Id, &workspace.Name, &createdAt, &updatedAt)
+	if err != nil {
+		return pb.Workspace{}, err
+	}
+
+	_, workspace.CreatedTime = timestamp(createdAt)
+	_, workspace.UpdatedTime = timestamp(updatedAt)
+	return workspace, nil
+}
@@ -0,0 +1,34 @@
+package repo
+
+import (
+	"fmt"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+)
+
+// TaskPatch is one item of BatchUpdate: the fields of Task to write, all of them when Fields is nil.
+type TaskPatch struct {
+	Task   pb.Task
+	Fields []string
+}
+
+// BatchResult is the outcome of one item of a batch call.
+type BatchResult struct {
+	Task pb.Task
+	Err  error
+}
+
+// BatchItemError is returned by an all-or-nothing batch call whose item at Index failed.
+// Nothing of the batch was written.
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
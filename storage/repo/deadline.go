@@ -0,0 +1,15 @@
+package repo
+
+import "time"
+
+// ParseOverdueCutoff reads ByDeadlineReq.Deadline: the service resolves it to an RFC3339
+// timestamp, and a bare 2006-01-02 date is still taken as midnight UTC.
+func ParseOverdueCutoff(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, &FieldError{Field: "deadline", Description: "must be an RFC3339 timestamp or a date in YYYY-MM-DD format"}
+}
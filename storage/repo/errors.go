@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Storage implementations translate their driver errors into these, so callers
+// can tell what went wrong with errors.Is without knowing the backend.
+var (
+	// ErrNotFound is returned when the task doesn't exist or was deleted.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidArgument is returned when the storage rejects a value it was given.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrConflict is returned when a write carries an expected version that no longer matches the stored one,
+	// or when it would duplicate an existing row.
+	ErrConflict = errors.New("version conflict")
+	// ErrInUse is returned when a row can't be deleted because others still refer to it.
+	ErrInUse = errors.New("in use")
+	// ErrParentDeleted is returned when a subtask is restored while its parent is still deleted.
+	ErrParentDeleted = errors.New("parent task is deleted")
+	// ErrUnavailable is returned when the storage can't be reached; the call may succeed if retried.
+	ErrUnavailable = errors.New("storage unavailable")
+)
+
+// FieldError is an ErrInvalidArgument caused by a single request field.
+type FieldError struct {
+	Field       string
+	Description string
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Description
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Description)
+}
+
+// Is makes errors.Is(err, ErrInvalidArgument) hold for every FieldError.
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// Actions of the changes task history records.
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionRestore = "restore"
+)
+
+// historyFields are the task fields history tracks, in the order a change lists them.
+var historyFields = []string{"assignee", "title", "summary", "deadline", "time_zone", "status", "recurrence", "project_id", "parent_id"}
+
+// FieldChanges returns the tracked fields whose value differs between before and after.
+// A create compares against the zero task, so it lists the fields that were set.
+func FieldChanges(before, after pb.Task) []*pb.FieldChange {
+	var changes []*pb.FieldChange
+	for _, field := range historyFields {
+		old, value := fieldValue(before, field), fieldValue(after, field)
+		if old != value {
+			changes = append(changes, &pb.FieldChange{Field: field, OldValue: old, NewValue: value})
+		}
+	}
+	return changes
+}
+
+func fieldValue(task pb.Task, field string) string {
+	switch field {
+	case "assignee":
+		return task.Assignee
+	case "title":
+		return task.Title
+	case "summary":
+		return task.Summary
+	case "deadline":
+		if task.DeadlineTime == nil {
+			return ""
+		}
+		t, err := types.TimestampFromProto(task.DeadlineTime)
+		if err != nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	case "time_zone":
+		return task.TimeZone
+	case "status":
+		return task.Status
+	case "recurrence":
+		return task.Recurrence
+	case "project_id":
+		return task.ProjectId
+	case "parent_id":
+		return task.ParentId
+	}
+	return ""
+}
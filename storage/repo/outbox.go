@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+)
+
+// EventType names a change to a task; webhooks subscribe to them by name.
+type EventType string
+
+const (
+	EventTaskCreated EventType = "task.created"
+	// EventTaskUpdated is written for every change of a stored task, status changes and restores included.
+	EventTaskUpdated EventType = "task.updated"
+	// EventTaskCompleted is written along with EventTaskUpdated when a task becomes done.
+	EventTaskCompleted EventType = "task.completed"
+	EventTaskDeleted   EventType = "task.deleted"
+)
+
+// EventTypes are all the events task storage writes.
+var EventTypes = []EventType{EventTaskCreated, EventTaskUpdated, EventTaskCompleted, EventTaskDeleted}
+
+// Valid tells whether t is one of EventTypes.
+func (t EventType) Valid() bool {
+	for _, known := range EventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangeEvents are the events of task having been changed from status from.
+func ChangeEvents(from string, task pb.Task) []EventType {
+	if task.Status == "done" && from != "done" {
+		return []EventType{EventTaskUpdated, EventTaskCompleted}
+	}
+	return []EventType{EventTaskUpdated}
+}
+
+// Event is a change to a task, written to the outbox in the same transaction as the change
+// itself, so there is an event for every stored change and none for a rolled back one.
+// For EventTaskDeleted, Task is the task as it was deleted.
+type Event struct {
+	// Seq orders the events of the outbox.
+	Seq int64
+	// Revision numbers the delivered events in the order of delivery, from 1 and without
+	// gaps. It is 0 until the event is delivered.
+	Revision   int64
+	ID         string
+	Type       EventType
+	Task       pb.Task
+	OccurredAt time.Time
+}
+
+// OutboxStorageI reads the events TaskStorageI writes with every change of a task. Purges
+// write none: they remove tasks whose deletion was already an event.
+type OutboxStorageI interface {
+	// Relay hands up to limit undelivered events, oldest first, to publish, and marks the
+	// first n of them delivered at the given time, n being what publish returns. Only one
+	// relay runs at a time, so events are published in order; a call made while another
+	// one runs hands nothing over. It reports how many events were marked delivered, and
+	// numbers them with the revisions that follow LastRevision. publish must return within
+	// lease: after that, another relay may hand the same events over again.
+	Relay(limit int, at time.Time, lease time.Duration, publish func(events []Event) int) (int, error)
+	// Since returns up to limit delivered events with a revision above revision, in
+	// revision order.
+	Since(revision int64, limit int) ([]Event, error)
+	// LastRevision returns the revision of the last delivered event, 0 before the first.
+	LastRevision() (int64, error)
+}
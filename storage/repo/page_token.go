@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidPageToken is returned when a page token can't be decoded.
+var ErrInvalidPageToken = &FieldError{Field: "page_token", Description: "invalid page token"}
+
+// PageToken is the (created_at, id) keyset position of the last task on a page.
+// Clients only ever see it encoded, so its format can change without breaking them.
+type PageToken struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// Encode ...
+func (t PageToken) Encode() string {
+	raw := t.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + t.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodePageToken ...
+func DecodePageToken(token string) (PageToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return PageToken{}, ErrInvalidPageToken
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return PageToken{}, ErrInvalidPageToken
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return PageToken{}, ErrInvalidPageToken
+	}
+
+	return PageToken{CreatedAt: createdAt, ID: parts[1]}, nil
+}
+
+// SearchToken is the (rank, id) keyset position of the last result on a page of Search.
+// A task's rank only depends on the query, so it is stable between pages.
+type SearchToken struct {
+	Rank float32
+	ID   string
+}
+
+// Encode ...
+func (t SearchToken) Encode() string {
+	raw := strconv.FormatFloat(float64(t.Rank), 'g', -1, 32) + "|" + t.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeSearchToken ...
+func DecodeSearchToken(token string) (SearchToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return SearchToken{}, ErrInvalidPageToken
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return SearchToken{}, ErrInvalidPageToken
+	}
+
+	rank, err := strconv.ParseFloat(parts[0], 32)
+	if err != nil {
+		return SearchToken{}, ErrInvalidPageToken
+	}
+
+	return SearchToken{Rank: float32(rank), ID: parts[1]}, nil
+}
@@ -0,0 +1,21 @@
+package repo
+
+import (
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+)
+
+// ProjectStorageI stores the projects that group the tasks of a workspace.
+type ProjectStorageI interface {
+	// InWorkspace returns the repository limited to the projects of workspace id: other
+	// projects are not found, and projects are created in it.
+	InWorkspace(id string) ProjectStorageI
+	Create(pb.Project) (pb.Project, error)
+	Get(id string) (pb.Project, error)
+	// List returns the projects, oldest first, archived ones only when req asks for them.
+	List(req pb.ListProjectsReq) (pb.ListProjectsResp, error)
+	// Update writes name, description, color and archived.
+	Update(pb.Project) (pb.Project, error)
+	// Delete removes the project, unless it still has tasks, deleted ones included, in
+	// which case it returns ErrInUse.
+	Delete(id string) error
+}
@@ -0,0 +1,40 @@
+package repo
+
+import (
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+)
+
+// ReminderKind tells what a reminder is about.
+type ReminderKind string
+
+const (
+	// ReminderUpcoming is sent once a deadline is near.
+	ReminderUpcoming ReminderKind = "upcoming"
+	// ReminderOverdue is sent once a deadline has passed.
+	ReminderOverdue ReminderKind = "overdue"
+)
+
+// Reminder identifies one reminder. A task gets at most one of each kind per deadline,
+// so moving the deadline makes it due again.
+type Reminder struct {
+	TaskID   string
+	Kind     ReminderKind
+	Deadline time.Time
+}
+
+// ReminderStorageI records which reminders were sent, and which failed.
+type ReminderStorageI interface {
+	// Due returns up to limit open tasks, live and neither done nor cancelled, whose deadline is in
+	// [from, to) and that have no reminder of kind for it yet, or one whose failed delivery is to
+	// be retried by now, earliest deadline first. A zero from means no lower bound.
+	Due(kind ReminderKind, from, to, now time.Time, limit int) ([]pb.Task, error)
+	// Claim records r as sent at the given time and returns which attempt at sending it this is,
+	// so that schedulers running side by side send each reminder once. It returns 0 when r was
+	// claimed already and is not to be retried by then.
+	Claim(r Reminder, at time.Time) (int, error)
+	// Fail records that the delivery of r failed. It becomes due again at retryAt, or never
+	// when retryAt is zero.
+	Fail(r Reminder, retryAt time.Time) error
+}
@@ -1,6 +1,8 @@
 package repo
 
 import (
+	"time"
+
 	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
 )
 
@@ -8,8 +10,43 @@ import (
 type TaskStorageI interface {
 	Create(pb.Task) (pb.Task, error)
 	Get(id string) (pb.Task, error)
-	List(page, limit int64) ([]*pb.Task, int64, error)
+	List(req pb.ListReq) (pb.ListResp, error)
 	Update(pb.Task) (pb.Task, error)
-	Delete(id string) error
-	ListOverdue(deadline string, page, limit int64) ([]*pb.Task, int64, error)
+	Patch(task pb.Task, fields []string) (pb.Task, error)
+	// Delete soft-deletes the task along with its subtasks, at any depth.
+	Delete(id string, version int64) error
+	ChangeStatus(id, from, to string) (pb.Task, error)
+	ListOverdue(req pb.ByDeadlineReq) (pb.ListResp, error)
+	// Restore undoes Delete, for the subtasks deleted along with the task too. It returns
+	// ErrParentDeleted for a subtask whose parent is deleted.
+	Restore(id string) (pb.Task, error)
+	ListDeleted(req pb.ListReq) (pb.ListResp, error)
+	// Purge and PurgeDeletedBefore remove the subtasks of the tasks they purge too, which
+	// PurgeDeletedBefore counts.
+	Purge(id string) error
+	PurgeDeletedBefore(t time.Time) (int64, error)
+	GetMany(ids []string) ([]pb.Task, error)
+	// BatchCreate, BatchUpdate and BatchDelete run in a single transaction. When atomic, the first
+	// failing item rolls the batch back and is returned as a *BatchItemError; otherwise every item
+	// is tried and its failure is reported in its BatchResult.
+	BatchCreate(tasks []pb.Task, atomic bool) ([]BatchResult, error)
+	BatchUpdate(patches []TaskPatch, atomic bool) ([]BatchResult, error)
+	BatchDelete(items []pb.ByIdReq, atomic bool) ([]BatchResult, error)
+	Search(req pb.SearchReq) (pb.SearchResp, error)
+	// ListSubtasks returns the live subtasks of req.ParentId in the List form, oldest first,
+	// or with req.Recursive all of its live descendants, shallowest first. It returns
+	// ErrNotFound when the parent is not found.
+	ListSubtasks(req pb.ListSubtasksReq) (pb.ListResp, error)
+	// WithActor returns the repository the same tasks are changed through on behalf of
+	// actor, who history records as the author of the changes.
+	WithActor(actor string) TaskStorageI
+	// VisibleTo returns the repository limited to the tasks user owns or is assigned to:
+	// other tasks are not found, by reads and writes alike. Creates are not limited.
+	VisibleTo(user string) TaskStorageI
+	// InWorkspace returns the repository limited to the tasks of workspace id: other tasks
+	// are not found, by reads and writes alike, and tasks are created in it.
+	InWorkspace(id string) TaskStorageI
+	// History returns the changes made to a task by Create, Update, Patch, ChangeStatus,
+	// Delete, Restore and the batch calls, latest first. Purges keep it.
+	History(req pb.TaskHistoryReq) (pb.TaskHistoryResp, error)
 }
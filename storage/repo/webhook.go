@@ -0,0 +1,40 @@
+package repo
+
+import (
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+)
+
+// DeadLetter is an event a webhook didn't accept by the last retry, kept so it can be
+// inspected and replayed.
+type DeadLetter struct {
+	ID        string
+	WebhookID string
+	EventID   string
+	Event     string
+	Payload   []byte
+	Attempts  int
+	LastError string
+	CreatedAt time.Time
+}
+
+// WebhookStorageI stores webhook subscriptions and what happened to their deliveries.
+// Webhooks are returned with their secret; hiding it from clients is up to the caller.
+type WebhookStorageI interface {
+	Create(pb.Webhook) (pb.Webhook, error)
+	Get(id string) (pb.Webhook, error)
+	List(req pb.ListWebhooksReq) (pb.ListWebhooksResp, error)
+	// Update writes url, events and disabled, and the secret unless it is empty.
+	Update(pb.Webhook) (pb.Webhook, error)
+	// Delete removes the webhook along with its deliveries and dead letters.
+	Delete(id string) error
+	// Subscribers returns the enabled webhooks subscribed to event, oldest first.
+	Subscribers(event string) ([]pb.Webhook, error)
+	RecordDelivery(pb.WebhookDelivery) error
+	// ListDeliveries returns the delivery attempts of a webhook, latest first.
+	ListDeliveries(req pb.ListWebhookDeliveriesReq) (pb.ListWebhookDeliveriesResp, error)
+	AddDeadLetter(DeadLetter) error
+	// ListDeadLetters returns the dead letters of a webhook, oldest first.
+	ListDeadLetters(webhookID string) ([]DeadLetter, error)
+}
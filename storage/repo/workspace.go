@@ -0,0 +1,21 @@
+package repo
+
+import (
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+)
+
+// DefaultWorkspaceID is the workspace of the tasks created without one. It always exists.
+const DefaultWorkspaceID = "00000000-0000-0000-0000-000000000000"
+
+// WorkspaceStorageI stores the workspaces tasks are kept apart in.
+type WorkspaceStorageI interface {
+	Create(pb.Workspace) (pb.Workspace, error)
+	Get(id string) (pb.Workspace, error)
+	// List returns the workspaces, oldest first.
+	List(req pb.ListWorkspacesReq) (pb.ListWorkspacesResp, error)
+	// Update renames the workspace.
+	Update(pb.Workspace) (pb.Workspace, error)
+	// Delete removes the workspace, unless it still has projects or tasks, deleted ones
+	// included, in which case it returns ErrInUse.
+	Delete(id string) error
+}
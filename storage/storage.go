@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"github.com/NafisaTojiboyeva/todo-service/storage/memory"
 	"github.com/NafisaTojiboyeva/todo-service/storage/postgres"
 	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
 
@@ -9,20 +10,60 @@ import (
 
 type IStorage interface {
 	Task() repo.TaskStorageI
+	Reminder() repo.ReminderStorageI
+	Webhook() repo.WebhookStorageI
+	Outbox() repo.OutboxStorageI
+	Workspace() repo.WorkspaceStorageI
+	Project() repo.ProjectStorageI
 }
 
 type storagePg struct {
-	db       *sqlx.DB
-	taskRepo repo.TaskStorageI
+	db            *sqlx.DB
+	taskRepo      repo.TaskStorageI
+	reminderRepo  repo.ReminderStorageI
+	webhookRepo   repo.WebhookStorageI
+	outboxRepo    repo.OutboxStorageI
+	workspaceRepo repo.WorkspaceStorageI
+	projectRepo   repo.ProjectStorageI
 }
 
 func NewStoragePg(db *sqlx.DB) *storagePg {
 	return &storagePg{
-		db:       db,
-		taskRepo: postgres.NewTaskRepo(db),
+		db:            db,
+		taskRepo:      postgres.NewTaskRepo(db),
+		reminderRepo:  postgres.NewReminderRepo(db),
+		webhookRepo:   postgres.NewWebhookRepo(db),
+		outboxRepo:    postgres.NewOutboxRepo(db),
+		workspaceRepo: postgres.NewWorkspaceRepo(db),
+		projectRepo:   postgres.NewProjectRepo(db),
 	}
 }
 
 func (s storagePg) Task() repo.TaskStorageI {
 	return s.taskRepo
 }
+
+func (s storagePg) Reminder() repo.ReminderStorageI {
+	return s.reminderRepo
+}
+
+func (s storagePg) Webhook() repo.WebhookStorageI {
+	return s.webhookRepo
+}
+
+func (s storagePg) Outbox() repo.OutboxStorageI {
+	return s.outboxRepo
+}
+
+func (s storagePg) Workspace() repo.WorkspaceStorageI {
+	return s.workspaceRepo
+}
+
+func (s storagePg) Project() repo.ProjectStorageI {
+	return s.projectRepo
+}
+
+// NewStorageMemory returns an empty storage that keeps everything in memory.
+func NewStorageMemory() IStorage {
+	return memory.NewStorage(memory.NewTaskRepo())
+}
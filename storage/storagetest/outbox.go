@@ -0,0 +1,191 @@
+package storagetest
+
+import (
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// OutboxStorageSuite checks a repo.OutboxStorageI against the events of changes made through
+// Tasks. Every test relays what is pending first and only looks at the events of its own tasks:
+//
+//	suite.Run(t, &storagetest.OutboxStorageSuite{Tasks: tasks, Outbox: outbox})
+type OutboxStorageSuite struct {
+	suite.Suite
+	Tasks  repo.TaskStorageI
+	Outbox repo.OutboxStorageI
+}
+
+func (s *OutboxStorageSuite) SetupTest() {
+	s.relay(1 << 20)
+}
+
+func (s *OutboxStorageSuite) create(title string) pb.Task {
+	id, err := uuid.NewV4()
+	s.Require().NoError(err)
+	task, err := s.Tasks.Create(pb.Task{Id: id.String(), Title: title, Status: "todo"})
+	s.Require().NoError(err)
+	return task
+}
+
+// relay hands up to limit pending events over and takes all of them.
+func (s *OutboxStorageSuite) relay(limit int) []repo.Event {
+	var events []repo.Event
+	n, err := s.Outbox.Relay(limit, time.Now(), time.Minute, func(pending []repo.Event) int {
+		events = pending
+		return len(pending)
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(len(events), n)
+	return events
+}
+
+// own returns the events about the given tasks as "type title" strings.
+func own(events []repo.Event, tasks ...pb.Task) []string {
+	mine := map[string]bool{}
+	for _, task := range tasks {
+		mine[task.Id] = true
+	}
+	got := []string{}
+	for _, e := range events {
+		if mine[e.Task.Id] {
+			got = append(got, string(e.Type)+" "+e.Task.Title)
+		}
+	}
+	return got
+}
+
+func (s *OutboxStorageSuite) TestEvents() {
+	task := s.create("Write report")
+	task.Title = "Write the report"
+	task, err := s.Tasks.Patch(task, []string{"title"})
+	s.Require().NoError(err)
+	task.Status = "done"
+	_, err = s.Tasks.Patch(task, []string{"status"})
+	s.Require().NoError(err)
+	_, err = s.Tasks.ChangeStatus(task.Id, "done", "todo")
+	s.Require().NoError(err)
+	s.Require().NoError(s.Tasks.Delete(task.Id, 0))
+	_, err = s.Tasks.Restore(task.Id)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Tasks.Delete(task.Id, 0))
+	s.Require().NoError(s.Tasks.Purge(task.Id))
+
+	events := s.relay(1000)
+	s.Equal([]string{
+		"task.created Write report",
+		"task.updated Write the report",
+		"task.updated Write the report",
+		"task.completed Write the report",
+		"task.updated Write the report",
+		"task.deleted Write the report",
+		"task.updated Write the report",
+		"task.deleted Write the report",
+	}, own(events, task), "purges write no events")
+
+	ids := map[string]bool{}
+	for i, e := range events {
+		s.NotEmpty(e.ID)
+		s.False(ids[e.ID], "event ids are unique")
+		ids[e.ID] = true
+		s.False(e.OccurredAt.IsZero())
+		if i > 0 {
+			s.Greater(e.Seq, events[i-1].Seq, "events are relayed in order")
+		}
+	}
+
+	s.Empty(own(s.relay(1000), task), "relayed events are delivered")
+}
+
+func (s *OutboxStorageSuite) TestFailedChangesWriteNoEvents() {
+	task := s.create("Keep me")
+	s.relay(1000)
+
+	_, err := s.Tasks.ChangeStatus(task.Id, "done", "todo")
+	s.Require().ErrorIs(err, repo.ErrConflict)
+	s.Require().ErrorIs(s.Tasks.Delete(task.Id, task.Version+1), repo.ErrConflict)
+
+	id, err := uuid.NewV4()
+	s.Require().NoError(err)
+	batched := pb.Task{Id: id.String(), Title: "Batched", Status: "todo"}
+	_, err = s.Tasks.BatchCreate([]pb.Task{batched, {Id: task.Id, Title: "Duplicate", Status: "todo"}}, true)
+	s.Require().Error(err)
+
+	s.Empty(own(s.relay(1000), task, batched), "nothing was stored, so there are no events")
+}
+
+func (s *OutboxStorageSuite) TestPartialPublish() {
+	one, two, three := s.create("One"), s.create("Two"), s.create("Three")
+
+	n, err := s.Outbox.Relay(1000, time.Now(), time.Minute, func(events []repo.Event) int {
+		s.Equal([]string{"task.created One", "task.created Two", "task.created Three"}, own(events, one, two, three))
+		return 1
+	})
+	s.Require().NoError(err)
+	s.Equal(1, n)
+
+	n, err = s.Outbox.Relay(1000, time.Now(), time.Minute, func(events []repo.Event) int { return 0 })
+	s.Require().NoError(err)
+	s.Equal(0, n, "nothing published, nothing delivered")
+
+	s.Equal([]string{"task.created Two"}, own(s.relay(1), one, two, three), "the limit caps the events handed over")
+	s.Equal([]string{"task.created Three"}, own(s.relay(1000), one, two, three))
+}
+
+func (s *OutboxStorageSuite) TestOneRelayAtATime() {
+	task := s.create("Relay me")
+
+	n, err := s.Outbox.Relay(1000, time.Now(), time.Minute, func(events []repo.Event) int {
+		nested, err := s.Outbox.Relay(1000, time.Now(), time.Minute, func(events []repo.Event) int {
+			s.Fail("a relay ran while another one did")
+			return len(events)
+		})
+		s.NoError(err)
+		s.Equal(0, nested)
+		return len(events)
+	})
+	s.Require().NoError(err)
+	s.Equal(1, n)
+
+	s.Empty(own(s.relay(1000), task))
+}
+
+func (s *OutboxStorageSuite) TestRevisions() {
+	before, err := s.Outbox.LastRevision()
+	s.Require().NoError(err)
+	one, two := s.create("One"), s.create("Two")
+
+	var pending []repo.Event
+	n, err := s.Outbox.Relay(1000, time.Now(), time.Minute, func(events []repo.Event) int {
+		pending = events
+		return len(events)
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(2, n)
+	for _, e := range pending {
+		s.Zero(e.Revision, "events get a revision once they are delivered")
+	}
+
+	last, err := s.Outbox.LastRevision()
+	s.Require().NoError(err)
+	s.Equal(before+2, last)
+
+	events, err := s.Outbox.Since(before, 1000)
+	s.Require().NoError(err)
+	s.Equal([]string{"task.created One", "task.created Two"}, own(events, one, two))
+	s.Require().Len(events, 2)
+	s.Equal([]int64{before + 1, before + 2}, []int64{events[0].Revision, events[1].Revision})
+	s.Equal(pending[0].ID, events[0].ID)
+
+	events, err = s.Outbox.Since(before, 1)
+	s.Require().NoError(err)
+	s.Equal([]string{"task.created One"}, own(events, one, two), "the limit caps the events")
+
+	events, err = s.Outbox.Since(last, 1000)
+	s.Require().NoError(err)
+	s.Empty(events)
+}
@@ -0,0 +1,198 @@
+package storagetest
+
+import (
+	"errors"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// ProjectStorageSuite checks a repo.ProjectStorageI together with the workspace and task
+// repositories of the same storage. Every test works in workspaces of its own, so the
+// storage may be shared:
+//
+//	suite.Run(t, &storagetest.ProjectStorageSuite{Projects: projects, Workspaces: workspaces, Tasks: tasks})
+type ProjectStorageSuite struct {
+	suite.Suite
+	Projects   repo.ProjectStorageI
+	Workspaces repo.WorkspaceStorageI
+	Tasks      repo.TaskStorageI
+
+	prefix string
+}
+
+func (s *ProjectStorageSuite) SetupTest() {
+	s.prefix = "test-" + s.newID()[:8]
+}
+
+func (s *ProjectStorageSuite) newID() string {
+	id, err := uuid.NewV4()
+	s.Require().NoError(err)
+	return id.String()
+}
+
+// workspace stores a workspace named after the test, removed with its tasks and projects
+// when the test ends.
+func (s *ProjectStorageSuite) workspace(name string) pb.Workspace {
+	workspace, err := s.Workspaces.Create(pb.Workspace{Id: s.newID(), Name: s.prefix + "-" + name})
+	s.Require().NoError(err)
+	s.T().Cleanup(func() {
+		tasks := s.Tasks.InWorkspace(workspace.Id)
+		for _, list := range []func(pb.ListReq) (pb.ListResp, error){tasks.List, tasks.ListDeleted} {
+			resp, err := list(pb.ListReq{Page: 1, Limit: 100, IncludeArchived: true})
+			if err != nil {
+				s.T().Errorf("failed to list the tasks of workspace %s: %v", workspace.Id, err)
+				return
+			}
+			for _, task := range resp.Tasks {
+				if err := tasks.Delete(task.Id, 0); err != nil && !errors.Is(err, repo.ErrNotFound) {
+					s.T().Errorf("failed to clean up task %s: %v", task.Id, err)
+				}
+				if err := tasks.Purge(task.Id); err != nil {
+					s.T().Errorf("failed to clean up task %s: %v", task.Id, err)
+				}
+			}
+		}
+		projects := s.Projects.InWorkspace(workspace.Id)
+		resp, err := projects.List(pb.ListProjectsReq{Page: 1, Limit: 100, IncludeArchived: true})
+		if err != nil {
+			s.T().Errorf("failed to list the projects of workspace %s: %v", workspace.Id, err)
+			return
+		}
+		for _, project := range resp.Projects {
+			if err := projects.Delete(project.Id); err != nil {
+				s.T().Errorf("failed to clean up project %s: %v", project.Id, err)
+			}
+		}
+		if err := s.Workspaces.Delete(workspace.Id); err != nil && !errors.Is(err, repo.ErrNotFound) {
+			s.T().Errorf("failed to clean up workspace %s: %v", workspace.Id, err)
+		}
+	})
+	return workspace
+}
+
+func (s *ProjectStorageSuite) TestCRUD() {
+	projects := s.Projects.InWorkspace(s.workspace("crud").Id)
+	other := s.Projects.InWorkspace(s.workspace("other").Id)
+
+	created, err := projects.Create(pb.Project{Id: s.newID(), Name: "Launch", Description: "Ship it", Color: "#ff8800"})
+	s.Require().NoError(err)
+	s.Equal("Launch", created.Name)
+	s.Equal("#ff8800", created.Color)
+	s.False(created.Archived)
+	s.NotNil(created.CreatedTime)
+
+	got, err := projects.Get(created.Id)
+	s.Require().NoError(err)
+	s.Equal(created, got)
+	_, err = other.Get(created.Id)
+	s.ErrorIs(err, repo.ErrNotFound, "projects are kept to their workspace")
+
+	_, err = projects.Create(pb.Project{Id: s.newID(), Name: "Launch"})
+	s.ErrorIs(err, repo.ErrConflict, "names are unique in a workspace")
+	_, err = other.Create(pb.Project{Id: s.newID(), Name: "Launch"})
+	s.NoError(err, "but may repeat across workspaces")
+
+	updated, err := projects.Update(pb.Project{Id: created.Id, Name: "Relaunch", Archived: true})
+	s.Require().NoError(err)
+	s.Equal("Relaunch", updated.Name)
+	s.Empty(updated.Description)
+	s.True(updated.Archived)
+	s.Equal(created.CreatedTime, updated.CreatedTime)
+	_, err = other.Update(pb.Project{Id: created.Id, Name: "Stolen"})
+	s.ErrorIs(err, repo.ErrNotFound)
+
+	s.ErrorIs(other.Delete(created.Id), repo.ErrNotFound)
+	s.Require().NoError(projects.Delete(created.Id))
+	_, err = projects.Get(created.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+	s.ErrorIs(projects.Delete(created.Id), repo.ErrNotFound)
+}
+
+func (s *ProjectStorageSuite) TestList() {
+	projects := s.Projects.InWorkspace(s.workspace("list").Id)
+	var ids []string
+	for _, name := range []string{"first", "second", "third"} {
+		project, err := projects.Create(pb.Project{Id: s.newID(), Name: name, Archived: name == "second"})
+		s.Require().NoError(err)
+		ids = append(ids, project.Id)
+	}
+
+	active, err := projects.List(pb.ListProjectsReq{Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(2), active.Count)
+	s.Require().Len(active.Projects, 2)
+	s.Equal(ids[0], active.Projects[0].Id)
+	s.Equal(ids[2], active.Projects[1].Id)
+
+	all, err := projects.List(pb.ListProjectsReq{Page: 2, Limit: 2, IncludeArchived: true})
+	s.Require().NoError(err)
+	s.Equal(int64(3), all.Count)
+	s.Require().Len(all.Projects, 1)
+	s.Equal(ids[2], all.Projects[0].Id)
+}
+
+func (s *ProjectStorageSuite) TestTasks() {
+	ours, theirs := s.workspace("ours"), s.workspace("theirs")
+	projects, tasks := s.Projects.InWorkspace(ours.Id), s.Tasks.InWorkspace(ours.Id)
+	launch, err := projects.Create(pb.Project{Id: s.newID(), Name: "Launch"})
+	s.Require().NoError(err)
+	docs, err := projects.Create(pb.Project{Id: s.newID(), Name: "Docs"})
+	s.Require().NoError(err)
+	foreign, err := s.Projects.InWorkspace(theirs.Id).Create(pb.Project{Id: s.newID(), Name: "Foreign"})
+	s.Require().NoError(err)
+
+	task, err := tasks.Create(pb.Task{Id: s.newID(), ProjectId: launch.Id, Assignee: s.prefix, Title: "Press release", Status: "todo"})
+	s.Require().NoError(err)
+	s.Equal(launch.Id, task.ProjectId)
+	loose, err := tasks.Create(pb.Task{Id: s.newID(), Assignee: s.prefix, Title: "Loose", Status: "todo"})
+	s.Require().NoError(err)
+	s.Empty(loose.ProjectId)
+
+	_, err = tasks.Create(pb.Task{Id: s.newID(), ProjectId: foreign.Id, Assignee: s.prefix, Title: "Astray", Status: "todo"})
+	s.ErrorIs(err, repo.ErrInvalidArgument, "tasks only go in projects of their workspace")
+	_, err = tasks.Create(pb.Task{Id: s.newID(), ProjectId: s.newID(), Assignee: s.prefix, Title: "Nowhere", Status: "todo"})
+	s.ErrorIs(err, repo.ErrInvalidArgument, "tasks only go in projects that exist")
+
+	inLaunch, err := tasks.List(pb.ListReq{Page: 1, Limit: 10, ProjectId: launch.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), inLaunch.Count)
+	s.Equal(task.Id, inLaunch.Tasks[0].Id)
+
+	// moving a task between projects
+	moved, err := tasks.Patch(pb.Task{Id: task.Id, ProjectId: docs.Id}, []string{"project_id"})
+	s.Require().NoError(err)
+	s.Equal(docs.Id, moved.ProjectId)
+	s.Equal("Press release", moved.Title)
+	_, err = tasks.Patch(pb.Task{Id: task.Id, ProjectId: foreign.Id}, []string{"project_id"})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+	history, err := tasks.History(pb.TaskHistoryReq{TaskId: task.Id, Page: 1, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(history.Changes, 1)
+	s.Equal([]*pb.FieldChange{{Field: "project_id", OldValue: launch.Id, NewValue: docs.Id}}, history.Changes[0].Fields)
+
+	// the tasks of archived projects are hidden unless asked for or listed by project
+	_, err = projects.Update(pb.Project{Id: docs.Id, Name: docs.Name, Archived: true})
+	s.Require().NoError(err)
+	list, err := tasks.List(pb.ListReq{Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), list.Count)
+	s.Equal(loose.Id, list.Tasks[0].Id)
+	list, err = tasks.List(pb.ListReq{Page: 1, Limit: 10, IncludeArchived: true})
+	s.Require().NoError(err)
+	s.Equal(int64(2), list.Count)
+	list, err = tasks.List(pb.ListReq{Page: 1, Limit: 10, ProjectId: docs.Id})
+	s.Require().NoError(err)
+	s.Equal(int64(1), list.Count)
+
+	s.ErrorIs(projects.Delete(docs.Id), repo.ErrInUse)
+	s.Require().NoError(tasks.Delete(task.Id, 0))
+	s.ErrorIs(projects.Delete(docs.Id), repo.ErrInUse, "a deleted task still belongs to its project")
+	_, err = tasks.Patch(pb.Task{Id: loose.Id}, []string{"project_id"})
+	s.Require().NoError(err)
+	s.Require().NoError(tasks.Purge(task.Id))
+	s.Require().NoError(projects.Delete(docs.Id))
+}
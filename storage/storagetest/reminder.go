@@ -0,0 +1,127 @@
+package storagetest
+
+import (
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/suite"
+)
+
+// ReminderStorageSuite checks a repo.ReminderStorageI against the tasks of Tasks. Every test
+// works on deadlines in a window of its own, far in the future, and only looks at its own tasks:
+//
+//	suite.Run(t, &storagetest.ReminderStorageSuite{Tasks: tasks, Reminders: reminders})
+type ReminderStorageSuite struct {
+	suite.Suite
+	Tasks     repo.TaskStorageI
+	Reminders repo.ReminderStorageI
+
+	base time.Time
+}
+
+func (s *ReminderStorageSuite) SetupTest() {
+	id, err := uuid.NewV4()
+	s.Require().NoError(err)
+	hours := int(id[0])<<16 | int(id[1])<<8 | int(id[2])
+	s.base = time.Date(2400, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(hours) * time.Hour)
+}
+
+// create stores a task with status and a deadline the given hours after the test's window starts.
+func (s *ReminderStorageSuite) create(status string, hours int) pb.Task {
+	id, err := uuid.NewV4()
+	s.Require().NoError(err)
+	deadline, err := types.TimestampProto(s.base.Add(time.Duration(hours) * time.Hour))
+	s.Require().NoError(err)
+
+	task, err := s.Tasks.Create(pb.Task{Id: id.String(), Title: "Remind me", DeadlineTime: deadline, Status: status})
+	s.Require().NoError(err)
+	return task
+}
+
+// due returns the ids of the test's own tasks among the ones due now.
+func (s *ReminderStorageSuite) due(kind repo.ReminderKind, from, to time.Time, own ...pb.Task) []string {
+	tasks, err := s.Reminders.Due(kind, from, to, time.Now(), 1000)
+	s.Require().NoError(err)
+
+	mine := map[string]bool{}
+	for _, task := range own {
+		mine[task.Id] = true
+	}
+	ids := []string{}
+	for _, task := range tasks {
+		if mine[task.Id] {
+			ids = append(ids, task.Id)
+		}
+	}
+	return ids
+}
+
+func (s *ReminderStorageSuite) reminder(task pb.Task, kind repo.ReminderKind) repo.Reminder {
+	deadline, err := types.TimestampFromProto(task.DeadlineTime)
+	s.Require().NoError(err)
+	return repo.Reminder{TaskID: task.Id, Kind: kind, Deadline: deadline}
+}
+
+func (s *ReminderStorageSuite) TestDue() {
+	later := s.create("todo", 2)
+	sooner := s.create("in_progress", 1)
+	done := s.create("done", 1)
+	outside := s.create("todo", 10)
+	deleted := s.create("todo", 1)
+	s.Require().NoError(s.Tasks.Delete(deleted.Id, 0))
+	own := []pb.Task{later, sooner, done, outside, deleted}
+
+	s.Equal([]string{sooner.Id, later.Id}, s.due(repo.ReminderUpcoming, s.base, s.base.Add(5*time.Hour), own...))
+	s.Equal([]string{sooner.Id, later.Id}, s.due(repo.ReminderOverdue, time.Time{}, s.base.Add(5*time.Hour), own...), "zero from has no lower bound")
+	s.Equal([]string{later.Id}, s.due(repo.ReminderUpcoming, s.base.Add(2*time.Hour), s.base.Add(5*time.Hour), own...), "from is inclusive")
+
+	tasks, err := s.Reminders.Due(repo.ReminderUpcoming, s.base, s.base.Add(5*time.Hour), time.Now(), 1)
+	s.Require().NoError(err)
+	s.Len(tasks, 1)
+}
+
+func (s *ReminderStorageSuite) TestClaimAndFail() {
+	task := s.create("todo", 1)
+	from, to := s.base, s.base.Add(5*time.Hour)
+	upcoming := s.reminder(task, repo.ReminderUpcoming)
+
+	attempt, err := s.Reminders.Claim(upcoming, time.Now())
+	s.Require().NoError(err)
+	s.Equal(1, attempt)
+	attempt, err = s.Reminders.Claim(upcoming, time.Now())
+	s.Require().NoError(err)
+	s.Zero(attempt, "a reminder is claimed once")
+
+	s.Empty(s.due(repo.ReminderUpcoming, from, to, task))
+	s.Equal([]string{task.Id}, s.due(repo.ReminderOverdue, from, to, task), "kinds are tracked apart")
+
+	// a failed reminder waits for its retry, and is claimed again once it is due
+	s.Require().NoError(s.Reminders.Fail(upcoming, time.Now().Add(time.Hour)))
+	s.Empty(s.due(repo.ReminderUpcoming, from, to, task))
+	attempt, err = s.Reminders.Claim(upcoming, time.Now())
+	s.Require().NoError(err)
+	s.Zero(attempt, "a retry is not claimed before it is due")
+	s.Require().NoError(s.Reminders.Fail(upcoming, time.Now().Add(-time.Minute)))
+	s.Equal([]string{task.Id}, s.due(repo.ReminderUpcoming, from, to, task))
+	attempt, err = s.Reminders.Claim(upcoming, time.Now())
+	s.Require().NoError(err)
+	s.Equal(2, attempt)
+	s.Empty(s.due(repo.ReminderUpcoming, from, to, task))
+
+	// one given up on is never due again
+	s.Require().NoError(s.Reminders.Fail(upcoming, time.Time{}))
+	s.Empty(s.due(repo.ReminderUpcoming, from, to, task))
+	attempt, err = s.Reminders.Claim(upcoming, time.Now())
+	s.Require().NoError(err)
+	s.Zero(attempt)
+
+	moved, err := types.TimestampProto(s.base.Add(3 * time.Hour))
+	s.Require().NoError(err)
+	_, err = s.Tasks.Patch(pb.Task{Id: task.Id, DeadlineTime: moved}, []string{"deadline"})
+	s.Require().NoError(err)
+	s.Equal([]string{task.Id}, s.due(repo.ReminderUpcoming, from, to, task), "a new deadline is due again")
+}
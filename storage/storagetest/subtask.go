@@ -0,0 +1,125 @@
+package storagetest
+
+import (
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// subtask stores a todo task of the test's assignee under parent.
+func (s *TaskStorageSuite) subtask(title, parent string) pb.Task {
+	task, err := s.Repository.Create(pb.Task{Id: s.newID(), ParentId: parent, Assignee: s.assignee, Title: title, Status: "todo"})
+	s.Require().NoError(err)
+	return task
+}
+
+func (s *TaskStorageSuite) TestSubtasks() {
+	root := s.create("Root", "")
+	first := s.subtask("First", root.Id)
+	second := s.subtask("Second", root.Id)
+	nested := s.subtask("Nested", first.Id)
+	s.Equal(root.Id, first.ParentId)
+
+	_, err := s.Repository.ChangeStatus(second.Id, "todo", "done")
+	s.Require().NoError(err)
+	got, err := s.Repository.Get(root.Id)
+	s.Require().NoError(err)
+	s.Equal(int64(2), got.SubtasksTotal)
+	s.Equal(int64(1), got.SubtasksDone)
+	list, err := s.Repository.List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee})
+	s.Require().NoError(err)
+	for _, task := range list.Tasks {
+		if task.Id == first.Id {
+			s.Equal(int64(1), task.SubtasksTotal, "lists roll up progress too")
+		}
+	}
+
+	direct, err := s.Repository.ListSubtasks(pb.ListSubtasksReq{ParentId: root.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(2), direct.Count)
+	s.Equal([]string{first.Id, second.Id}, s.ids(direct.Tasks))
+	tree, err := s.Repository.ListSubtasks(pb.ListSubtasksReq{ParentId: root.Id, Recursive: true, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(3), tree.Count)
+	s.Equal([]string{first.Id, second.Id, nested.Id}, s.ids(tree.Tasks))
+	page, err := s.Repository.ListSubtasks(pb.ListSubtasksReq{ParentId: root.Id, Recursive: true, Page: 2, Limit: 2})
+	s.Require().NoError(err)
+	s.Equal([]string{nested.Id}, s.ids(page.Tasks))
+	_, err = s.Repository.ListSubtasks(pb.ListSubtasksReq{ParentId: s.newID(), Page: 1, Limit: 10})
+	s.ErrorIs(err, repo.ErrNotFound)
+
+	_, err = s.Repository.Create(pb.Task{Id: s.newID(), ParentId: s.newID(), Assignee: s.assignee, Title: "Orphan", Status: "todo"})
+	s.ErrorIs(err, repo.ErrInvalidArgument, "parents must exist")
+	for _, parent := range []string{root.Id, first.Id, nested.Id} {
+		_, err = s.Repository.Patch(pb.Task{Id: root.Id, ParentId: parent}, []string{"parent_id"})
+		s.ErrorIs(err, repo.ErrInvalidArgument, "a task can't go under itself or its subtasks")
+	}
+
+	moved, err := s.Repository.Patch(pb.Task{Id: nested.Id, ParentId: second.Id}, []string{"parent_id"})
+	s.Require().NoError(err)
+	s.Equal(second.Id, moved.ParentId)
+	history, err := s.Repository.History(pb.TaskHistoryReq{TaskId: nested.Id, Page: 1, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(history.Changes, 1)
+	s.Equal([]*pb.FieldChange{{Field: "parent_id", OldValue: first.Id, NewValue: second.Id}}, history.Changes[0].Fields)
+
+	detached, err := s.Repository.Patch(pb.Task{Id: nested.Id}, []string{"parent_id"})
+	s.Require().NoError(err)
+	s.Empty(detached.ParentId)
+	got, err = s.Repository.Get(second.Id)
+	s.Require().NoError(err)
+	s.Zero(got.SubtasksTotal)
+}
+
+func (s *TaskStorageSuite) TestSubtaskCascade() {
+	root := s.create("Root", "")
+	child := s.subtask("Child", root.Id)
+	grandchild := s.subtask("Grandchild", child.Id)
+	dropped := s.subtask("Dropped", root.Id)
+
+	s.Require().NoError(s.Repository.Delete(dropped.Id, 0))
+	got, err := s.Repository.Get(root.Id)
+	s.Require().NoError(err)
+	s.Equal(int64(1), got.SubtasksTotal, "deleted subtasks don't count")
+	_, err = s.Repository.Create(pb.Task{Id: s.newID(), ParentId: dropped.Id, Assignee: s.assignee, Title: "Under deleted", Status: "todo"})
+	s.ErrorIs(err, repo.ErrInvalidArgument, "deleted tasks can't get subtasks")
+
+	s.Require().NoError(s.Repository.Delete(root.Id, 0))
+	for _, id := range []string{child.Id, grandchild.Id} {
+		_, err := s.Repository.Get(id)
+		s.ErrorIs(err, repo.ErrNotFound, "subtasks are deleted with their parent")
+	}
+	_, err = s.Repository.Restore(grandchild.Id)
+	s.ErrorIs(err, repo.ErrParentDeleted)
+
+	restored, err := s.Repository.Restore(root.Id)
+	s.Require().NoError(err)
+	s.Equal(int64(1), restored.SubtasksTotal)
+	tree, err := s.Repository.ListSubtasks(pb.ListSubtasksReq{ParentId: root.Id, Recursive: true, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal([]string{child.Id, grandchild.Id}, s.ids(tree.Tasks), "only the subtasks deleted along with it come back")
+	history, err := s.Repository.History(pb.TaskHistoryReq{TaskId: grandchild.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(history.Changes, 3)
+	s.Equal(repo.ActionRestore, history.Changes[0].Action)
+	s.Equal(repo.ActionDelete, history.Changes[1].Action)
+
+	// purging a task purges its subtasks, deleted before it or along with it
+	s.Require().NoError(s.Repository.Delete(root.Id, 0))
+	s.Require().NoError(s.Repository.Purge(root.Id))
+	deleted, err := s.Repository.ListDeleted(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee})
+	s.Require().NoError(err)
+	s.Zero(deleted.Count)
+	history, err = s.Repository.History(pb.TaskHistoryReq{TaskId: grandchild.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(history.Changes, 4, "purged subtasks keep their history")
+	s.Equal(repo.ActionDelete, history.Changes[0].Action)
+
+	parent := s.create("Parent", "")
+	s.subtask("Subtask", parent.Id)
+	s.Require().NoError(s.Repository.Delete(parent.Id, 0))
+	purged, err := s.Repository.VisibleTo(s.assignee).PurgeDeletedBefore(time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Equal(int64(2), purged, "purged subtasks are counted")
+}
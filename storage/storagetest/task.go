@@ -0,0 +1,556 @@
+// Package storagetest holds the conformance suite every repo.TaskStorageI implementation must pass.
+package storagetest
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/suite"
+)
+
+// TaskStorageSuite checks the behaviour services rely on. Every test works on tasks of its
+// own assignee, so the suite can run against a database that already holds other tasks:
+//
+//	suite.Run(t, &storagetest.TaskStorageSuite{Repository: repo})
+type TaskStorageSuite struct {
+	suite.Suite
+	Repository repo.TaskStorageI
+
+	assignee string
+}
+
+func (s *TaskStorageSuite) SetupTest() {
+	s.assignee = "conformance-" + s.newID()[:8]
+}
+
+func (s *TaskStorageSuite) newID() string {
+	id, err := uuid.NewV4()
+	s.Require().NoError(err)
+	return id.String()
+}
+
+// create stores a todo task of the test's assignee with the given title and deadline.
+func (s *TaskStorageSuite) create(title, deadline string) pb.Task {
+	task, err := s.Repository.Create(pb.Task{Id: s.newID(), Assignee: s.assignee, Title: title, Deadline: deadline, Status: "todo"})
+	s.Require().NoError(err)
+	return task
+}
+
+func (s *TaskStorageSuite) ids(tasks []*pb.Task) []string {
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.Id)
+	}
+	return ids
+}
+
+func (s *TaskStorageSuite) TestCreateAndGet() {
+	id := s.newID()
+	created, err := s.Repository.Create(pb.Task{Id: id, Assignee: s.assignee, Title: "Write tests", Summary: "conformance", Deadline: "2021-12-01", Status: "todo"})
+	s.Require().NoError(err)
+	s.Equal(id, created.Id)
+	s.Equal(int64(1), created.Version)
+	s.NotEmpty(created.CreatedAt)
+	s.Empty(created.UpdatedAt)
+
+	got, err := s.Repository.Get(id)
+	s.Require().NoError(err)
+	s.Equal(created, got)
+
+	_, err = s.Repository.Get(s.newID())
+	s.ErrorIs(err, repo.ErrNotFound)
+
+	_, err = s.Repository.Create(created)
+	s.ErrorIs(err, repo.ErrConflict)
+}
+
+func (s *TaskStorageSuite) TestTimestamps() {
+	deadline := time.Date(2021, 12, 1, 9, 30, 0, 0, time.UTC)
+	ts, err := types.TimestampProto(deadline)
+	s.Require().NoError(err)
+
+	byTime, err := s.Repository.Create(pb.Task{Id: s.newID(), Assignee: s.assignee, Title: "By time", DeadlineTime: ts, Deadline: "2030-01-01", Status: "todo"})
+	s.Require().NoError(err)
+	s.Equal(ts, byTime.DeadlineTime, "deadline_time takes precedence")
+	s.Equal("2021-12-01T09:30:00Z", byTime.Deadline)
+	s.Require().NotNil(byTime.CreatedTime)
+	s.Nil(byTime.UpdatedTime)
+
+	byOffset, err := s.Repository.Create(pb.Task{Id: s.newID(), Assignee: s.assignee, Title: "By offset", Deadline: "2021-12-01T14:30:00+05:00", Status: "todo"})
+	s.Require().NoError(err)
+	s.Equal(ts, byOffset.DeadlineTime, "deadlines are stored as instants and returned in UTC")
+
+	patched, err := s.Repository.Patch(pb.Task{Id: byTime.Id, Title: "Patched"}, []string{"title"})
+	s.Require().NoError(err)
+	s.Require().NotNil(patched.UpdatedTime)
+	s.Equal(byTime.CreatedTime, patched.CreatedTime)
+
+	s.Require().NoError(s.Repository.Delete(byTime.Id, 0))
+	deleted, err := s.Repository.ListDeleted(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee})
+	s.Require().NoError(err)
+	s.Require().Len(deleted.Tasks, 1)
+	s.NotNil(deleted.Tasks[0].DeletedTime)
+}
+
+func (s *TaskStorageSuite) TestCreateInvalid() {
+	tests := map[string]pb.Task{
+		"bad id":         {Id: "42", Title: "Bad id", Status: "todo"},
+		"unknown status": {Id: s.newID(), Title: "Bad status", Status: "someday"},
+		"long title":     {Id: s.newID(), Title: strings.Repeat("a", 51), Status: "todo"},
+		"bad deadline":   {Id: s.newID(), Title: "Bad deadline", Deadline: "someday", Status: "todo"},
+	}
+
+	for name, task := range tests {
+		_, err := s.Repository.Create(task)
+		s.ErrorIs(err, repo.ErrInvalidArgument, name)
+	}
+}
+
+func (s *TaskStorageSuite) TestPatch() {
+	task := s.create("Before", "2021-12-01")
+
+	patched, err := s.Repository.Patch(pb.Task{Id: task.Id, Title: "After", Version: task.Version}, []string{"title"})
+	s.Require().NoError(err)
+	s.Equal("After", patched.Title)
+	s.Equal(task.Deadline, patched.Deadline)
+	s.Equal(task.Version+1, patched.Version)
+	s.NotEmpty(patched.UpdatedAt)
+
+	cleared, err := s.Repository.Patch(pb.Task{Id: task.Id}, []string{"deadline"})
+	s.Require().NoError(err)
+	s.Empty(cleared.Deadline)
+
+	_, err = s.Repository.Patch(pb.Task{Id: task.Id, Title: "Stale", Version: task.Version}, []string{"title"})
+	s.ErrorIs(err, repo.ErrConflict)
+
+	_, err = s.Repository.Patch(pb.Task{Id: task.Id}, []string{"created_at"})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+
+	updated, err := s.Repository.Update(pb.Task{Id: task.Id, Assignee: s.assignee, Title: "Whole", Status: "done"})
+	s.Require().NoError(err)
+	s.Equal("done", updated.Status)
+	s.Equal("Whole", updated.Title)
+
+	_, err = s.Repository.Update(pb.Task{Id: s.newID(), Title: "Missing", Status: "todo"})
+	s.ErrorIs(err, repo.ErrNotFound)
+}
+
+func (s *TaskStorageSuite) TestChangeStatus() {
+	task := s.create("Move me", "")
+
+	moved, err := s.Repository.ChangeStatus(task.Id, "todo", "in_progress")
+	s.Require().NoError(err)
+	s.Equal("in_progress", moved.Status)
+	s.Equal(task.Version+1, moved.Version)
+
+	_, err = s.Repository.ChangeStatus(task.Id, "todo", "done")
+	s.ErrorIs(err, repo.ErrConflict)
+
+	_, err = s.Repository.ChangeStatus(s.newID(), "todo", "done")
+	s.ErrorIs(err, repo.ErrNotFound)
+}
+
+func (s *TaskStorageSuite) TestDeleteRestorePurge() {
+	task := s.create("Delete me", "")
+
+	s.ErrorIs(s.Repository.Delete(task.Id, task.Version+1), repo.ErrConflict)
+	s.Require().NoError(s.Repository.Delete(task.Id, task.Version))
+	s.ErrorIs(s.Repository.Delete(task.Id, 0), repo.ErrNotFound)
+
+	_, err := s.Repository.Get(task.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+
+	live, err := s.Repository.List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee})
+	s.Require().NoError(err)
+	s.Zero(live.Count)
+
+	deleted, err := s.Repository.ListDeleted(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee})
+	s.Require().NoError(err)
+	s.Require().Len(deleted.Tasks, 1)
+	s.Equal(task.Id, deleted.Tasks[0].Id)
+	s.NotEmpty(deleted.Tasks[0].DeletedAt)
+
+	// nothing was deleted that long ago
+	_, err = s.Repository.PurgeDeletedBefore(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+
+	restored, err := s.Repository.Restore(task.Id)
+	s.Require().NoError(err)
+	s.Equal(task.Version+2, restored.Version)
+	_, err = s.Repository.Restore(task.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+	s.ErrorIs(s.Repository.Purge(task.Id), repo.ErrNotFound)
+
+	s.Require().NoError(s.Repository.Delete(task.Id, 0))
+	s.Require().NoError(s.Repository.Purge(task.Id))
+	_, err = s.Repository.Restore(task.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+}
+
+func (s *TaskStorageSuite) TestHistory() {
+	alice := s.Repository.WithActor("alice")
+	task, err := alice.Create(pb.Task{Id: s.newID(), Assignee: s.assignee, Title: "Tracked", Status: "todo"})
+	s.Require().NoError(err)
+
+	bob := s.Repository.WithActor("bob")
+	_, err = bob.Patch(pb.Task{Id: task.Id, Assignee: "bob", Deadline: "2021-12-01T09:00:00Z"}, []string{"assignee", "deadline"})
+	s.Require().NoError(err)
+	_, err = bob.ChangeStatus(task.Id, "todo", "in_progress")
+	s.Require().NoError(err)
+	s.Require().NoError(bob.Delete(task.Id, 0))
+	restored, err := alice.Restore(task.Id)
+	s.Require().NoError(err)
+
+	// failed changes leave no history
+	_, err = bob.Patch(pb.Task{Id: task.Id, Title: "Stale", Version: 1}, []string{"title"})
+	s.ErrorIs(err, repo.ErrConflict)
+
+	history, err := s.Repository.History(pb.TaskHistoryReq{TaskId: task.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(5), history.Count)
+	s.Require().Len(history.Changes, 5)
+
+	actions := make([]string, len(history.Changes))
+	for i, change := range history.Changes {
+		actions[i] = change.Action + " by " + change.Actor
+		s.Equal(task.Id, change.TaskId)
+		s.NotNil(change.ChangedTime)
+	}
+	s.Equal([]string{"restore by alice", "delete by bob", "update by bob", "update by bob", "create by alice"}, actions)
+	s.Equal(restored.Version, history.Changes[0].Version)
+	s.Empty(history.Changes[0].Fields)
+	s.Equal([]*pb.FieldChange{{Field: "status", OldValue: "todo", NewValue: "in_progress"}}, history.Changes[2].Fields)
+	s.Equal([]*pb.FieldChange{
+		{Field: "assignee", OldValue: s.assignee, NewValue: "bob"},
+		{Field: "deadline", OldValue: "", NewValue: "2021-12-01T09:00:00Z"},
+	}, history.Changes[3].Fields)
+	s.Equal([]*pb.FieldChange{
+		{Field: "assignee", NewValue: s.assignee},
+		{Field: "title", NewValue: "Tracked"},
+		{Field: "status", NewValue: "todo"},
+	}, history.Changes[4].Fields)
+
+	page, err := s.Repository.History(pb.TaskHistoryReq{TaskId: task.Id, Page: 2, Limit: 2})
+	s.Require().NoError(err)
+	s.Equal(int64(5), page.Count)
+	s.Require().Len(page.Changes, 2)
+	s.Equal(history.Changes[2].Id, page.Changes[0].Id)
+
+	s.Require().NoError(s.Repository.Delete(task.Id, 0))
+	s.Require().NoError(s.Repository.Purge(task.Id))
+	purged, err := s.Repository.History(pb.TaskHistoryReq{TaskId: task.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(6), purged.Count, "purging keeps the history")
+
+	none, err := s.Repository.History(pb.TaskHistoryReq{TaskId: s.newID(), Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Zero(none.Count)
+	s.Empty(none.Changes)
+}
+
+func (s *TaskStorageSuite) TestBatchHistory() {
+	one, two := s.newID(), s.newID()
+	_, err := s.Repository.BatchCreate([]pb.Task{
+		{Id: one, Assignee: s.assignee, Title: "One", Status: "todo"},
+		{Id: one, Assignee: s.assignee, Title: "One again", Status: "todo"},
+	}, true)
+	s.Require().Error(err)
+	history, err := s.Repository.History(pb.TaskHistoryReq{TaskId: one, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Zero(history.Count, "a failed all-or-nothing batch leaves no history")
+
+	_, err = s.Repository.WithActor("carol").BatchCreate([]pb.Task{
+		{Id: one, Assignee: s.assignee, Title: "One", Status: "todo"},
+		{Id: two, Assignee: s.assignee, Title: "Two", Status: "todo"},
+	}, true)
+	s.Require().NoError(err)
+	for _, id := range []string{one, two} {
+		history, err := s.Repository.History(pb.TaskHistoryReq{TaskId: id, Page: 1, Limit: 10})
+		s.Require().NoError(err)
+		s.Require().Len(history.Changes, 1)
+		s.Equal(repo.ActionCreate, history.Changes[0].Action)
+		s.Equal("carol", history.Changes[0].Actor)
+	}
+}
+
+func (s *TaskStorageSuite) TestVisibleTo() {
+	owner, other := s.assignee+"-owner", s.assignee+"-other"
+	owned, err := s.Repository.Create(pb.Task{Id: s.newID(), Owner: owner, Assignee: s.assignee, Title: "Owned", Deadline: "1990-01-01", Status: "todo"})
+	s.Require().NoError(err)
+	s.Equal(owner, owned.Owner)
+	assigned, err := s.Repository.Create(pb.Task{Id: s.newID(), Owner: other, Assignee: s.assignee, Title: "Assigned", Deadline: "1990-01-01", Status: "todo"})
+	s.Require().NoError(err)
+
+	mine := s.Repository.VisibleTo(owner)
+	got, err := mine.Get(owned.Id)
+	s.Require().NoError(err)
+	s.Equal(owned, got)
+	_, err = mine.Get(assigned.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+
+	list, err := mine.List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee})
+	s.Require().NoError(err)
+	s.Equal(int64(1), list.Count)
+	s.Equal([]string{owned.Id}, s.ids(list.Tasks))
+	list, err = s.Repository.VisibleTo(s.assignee).List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee})
+	s.Require().NoError(err)
+	s.Equal(int64(2), list.Count, "the assignee sees both tasks")
+
+	overdue, err := mine.ListOverdue(pb.ByDeadlineReq{Deadline: "2000-01-01", Page: 1, Limit: 1000})
+	s.Require().NoError(err)
+	s.Contains(s.ids(overdue.Tasks), owned.Id)
+	s.NotContains(s.ids(overdue.Tasks), assigned.Id)
+
+	many, err := mine.GetMany([]string{owned.Id, assigned.Id})
+	s.Require().NoError(err)
+	s.Equal([]pb.Task{owned}, many)
+
+	// changes to hidden tasks fail as if the tasks did not exist
+	_, err = mine.Patch(pb.Task{Id: assigned.Id, Title: "Hijacked"}, []string{"title"})
+	s.ErrorIs(err, repo.ErrNotFound)
+	_, err = mine.ChangeStatus(assigned.Id, "todo", "done")
+	s.ErrorIs(err, repo.ErrNotFound)
+	s.ErrorIs(mine.Delete(assigned.Id, 0), repo.ErrNotFound)
+	got, err = s.Repository.Get(assigned.Id)
+	s.Require().NoError(err)
+	s.Equal(assigned, got)
+
+	history, err := mine.History(pb.TaskHistoryReq{TaskId: assigned.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Zero(history.Count)
+	history, err = mine.History(pb.TaskHistoryReq{TaskId: owned.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(1), history.Count)
+
+	// reassigning a task away keeps it visible to its owner, and only to them
+	_, err = mine.Patch(pb.Task{Id: owned.Id, Assignee: other}, []string{"assignee"})
+	s.Require().NoError(err)
+	_, err = s.Repository.VisibleTo(s.assignee).Get(owned.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+	s.Require().NoError(mine.Delete(owned.Id, 0))
+	_, err = s.Repository.VisibleTo(s.assignee).Restore(owned.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+	restored, err := mine.Restore(owned.Id)
+	s.Require().NoError(err)
+	s.Equal(owner, restored.Owner)
+
+	// the history of a purged task stays with who could see it last
+	s.Require().NoError(mine.Delete(owned.Id, 0))
+	s.Require().NoError(mine.Purge(owned.Id))
+	history, err = mine.History(pb.TaskHistoryReq{TaskId: owned.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(5), history.Count)
+	history, err = s.Repository.VisibleTo(s.assignee).History(pb.TaskHistoryReq{TaskId: owned.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Zero(history.Count)
+
+	// creating is not limited
+	created, err := s.Repository.VisibleTo(other).Create(pb.Task{Id: s.newID(), Owner: owner, Assignee: s.assignee, Title: "For someone else", Status: "todo"})
+	s.Require().NoError(err)
+	_, err = s.Repository.VisibleTo(other).Get(created.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+}
+
+func (s *TaskStorageSuite) TestListByOffset() {
+	late := s.create("Late", "2021-12-03")
+	early := s.create("Early", "2021-12-01")
+	undated := s.create("Undated", "")
+	gone := s.create("Gone", "2021-12-02")
+	s.Require().NoError(s.Repository.Delete(gone.Id, 0))
+
+	byDeadline, err := s.Repository.List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee, SortBy: "deadline", SortOrder: "desc"})
+	s.Require().NoError(err)
+	s.Equal(int64(3), byDeadline.Count)
+	s.Equal([]string{late.Id, early.Id, undated.Id}, s.ids(byDeadline.Tasks), "NULL deadlines sort last")
+
+	second, err := s.Repository.List(pb.ListReq{Page: 2, Limit: 2, Assignee: s.assignee, SortBy: "deadline"})
+	s.Require().NoError(err)
+	s.Equal(int64(3), second.Count)
+	s.Equal([]string{undated.Id}, s.ids(second.Tasks))
+
+	filtered, err := s.Repository.List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee, DeadlineFrom: "2021-12-02"})
+	s.Require().NoError(err)
+	s.Equal([]string{late.Id}, s.ids(filtered.Tasks))
+
+	_, err = s.Repository.List(pb.ListReq{Page: 1, Limit: 10, SortBy: "summary; drop table todos"})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+	_, err = s.Repository.List(pb.ListReq{Page: 1, Limit: 10, SortOrder: "sideways"})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+}
+
+func (s *TaskStorageSuite) TestListByPageToken() {
+	for _, title := range []string{"One", "Two", "Three"} {
+		s.create(title, "")
+	}
+	all, err := s.Repository.List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.assignee, SortBy: "created_at"})
+	s.Require().NoError(err)
+	s.Require().Len(all.Tasks, 3)
+
+	first, err := s.Repository.List(pb.ListReq{Limit: 2, Assignee: s.assignee})
+	s.Require().NoError(err)
+	s.Equal(s.ids(all.Tasks[:2]), s.ids(first.Tasks))
+	s.NotEmpty(first.NextPageToken)
+
+	second, err := s.Repository.List(pb.ListReq{Limit: 2, Assignee: s.assignee, PageToken: first.NextPageToken})
+	s.Require().NoError(err)
+	s.Equal(s.ids(all.Tasks[2:]), s.ids(second.Tasks))
+	s.Empty(second.NextPageToken)
+
+	desc, err := s.Repository.List(pb.ListReq{Limit: 1, Assignee: s.assignee, SortOrder: "desc"})
+	s.Require().NoError(err)
+	s.Equal(s.ids(all.Tasks[2:]), s.ids(desc.Tasks))
+
+	_, err = s.Repository.List(pb.ListReq{Limit: 2, PageToken: "not a token"})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+	_, err = s.Repository.List(pb.ListReq{Limit: 2, SortBy: "title", PageToken: first.NextPageToken})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+	_, err = s.Repository.List(pb.ListReq{Assignee: s.assignee})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+	_, err = s.Repository.List(pb.ListReq{Limit: 2, Assignee: s.assignee, SortOrder: "sideways"})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+}
+
+func (s *TaskStorageSuite) TestRecurrence() {
+	first := s.newID()
+	created, err := s.Repository.Create(pb.Task{
+		Id: first, Assignee: s.assignee, Title: "Water plants", Deadline: "2021-12-06T05:00:00Z", Status: "todo",
+		TimeZone: "Asia/Tashkent", Recurrence: "FREQ=WEEKLY", SeriesId: first, Occurrence: 1,
+	})
+	s.Require().NoError(err)
+	s.Equal("Asia/Tashkent", created.TimeZone)
+	s.Equal("FREQ=WEEKLY", created.Recurrence)
+	s.Equal(first, created.SeriesId)
+	s.Equal(int64(1), created.Occurrence)
+
+	next, err := s.Repository.Create(pb.Task{
+		Id: s.newID(), Assignee: s.assignee, Title: "Water plants", Deadline: "2021-12-13T05:00:00Z", Status: "todo",
+		TimeZone: "Asia/Tashkent", Recurrence: "FREQ=WEEKLY", SeriesId: first, Occurrence: 2,
+	})
+	s.Require().NoError(err)
+	s.create("Unrelated", "")
+
+	series, err := s.Repository.List(pb.ListReq{Page: 1, Limit: 10, SeriesId: first, SortBy: "deadline"})
+	s.Require().NoError(err)
+	s.Equal([]string{created.Id, next.Id}, s.ids(series.Tasks))
+	s.Equal(int64(2), series.Tasks[1].Occurrence)
+
+	stopped, err := s.Repository.Patch(pb.Task{Id: next.Id, SeriesId: first, Occurrence: 2}, []string{"recurrence"})
+	s.Require().NoError(err)
+	s.Empty(stopped.Recurrence)
+	s.Equal(first, stopped.SeriesId)
+	s.Equal("Asia/Tashkent", stopped.TimeZone, "time_zone is written with the deadline")
+
+	_, err = s.Repository.Create(pb.Task{Id: s.newID(), Title: "Bad series", Status: "todo", SeriesId: "42"})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+}
+
+func (s *TaskStorageSuite) TestListOverdue() {
+	overdue := s.create("Overdue", "1990-01-01")
+	upcoming := s.create("Upcoming", "2990-01-01")
+
+	got, err := s.Repository.ListOverdue(pb.ByDeadlineReq{Deadline: "2000-01-01", Page: 1, Limit: 1000})
+	s.Require().NoError(err)
+	s.Contains(s.ids(got.Tasks), overdue.Id)
+	s.NotContains(s.ids(got.Tasks), upcoming.Id)
+
+	got, err = s.Repository.ListOverdue(pb.ByDeadlineReq{Deadline: "1990-01-01T00:00:01Z", Page: 1, Limit: 1000})
+	s.Require().NoError(err)
+	s.Contains(s.ids(got.Tasks), overdue.Id)
+
+	_, err = s.Repository.ListOverdue(pb.ByDeadlineReq{Deadline: "01.01.2000", Page: 1, Limit: 10})
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+}
+
+func (s *TaskStorageSuite) TestGetMany() {
+	one, two := s.create("One", ""), s.create("Two", "")
+	s.Require().NoError(s.Repository.Delete(two.Id, 0))
+
+	got, err := s.Repository.GetMany([]string{one.Id, two.Id, s.newID()})
+	s.Require().NoError(err)
+	s.Equal([]pb.Task{one}, got)
+}
+
+func (s *TaskStorageSuite) TestBatch() {
+	one, two := s.newID(), s.newID()
+	tasks := []pb.Task{
+		{Id: one, Assignee: s.assignee, Title: "One", Status: "todo"},
+		{Id: two, Assignee: s.assignee, Title: "Two", Status: "todo"},
+		{Id: one, Assignee: s.assignee, Title: "One again", Status: "todo"},
+	}
+
+	_, err := s.Repository.BatchCreate(tasks, true)
+	var itemErr *repo.BatchItemError
+	s.Require().True(errors.As(err, &itemErr), "expected a batch item error, got: %v", err)
+	s.Equal(2, itemErr.Index)
+	s.ErrorIs(err, repo.ErrConflict)
+	_, err = s.Repository.Get(one)
+	s.ErrorIs(err, repo.ErrNotFound, "an all-or-nothing batch must not leave the items before the failed one")
+
+	created, err := s.Repository.BatchCreate(tasks, false)
+	s.Require().NoError(err)
+	s.Require().Len(created, 3)
+	s.NoError(created[0].Err)
+	s.NoError(created[1].Err)
+	s.ErrorIs(created[2].Err, repo.ErrConflict)
+	s.Equal(two, created[1].Task.Id)
+
+	patches := []repo.TaskPatch{
+		{Task: pb.Task{Id: one, Title: "One, renamed", Version: 1}, Fields: []string{"title"}},
+		{Task: pb.Task{Id: two, Title: "Stale", Version: 7}, Fields: []string{"title"}},
+	}
+	_, err = s.Repository.BatchUpdate(patches, true)
+	s.Require().True(errors.As(err, &itemErr), "expected a batch item error, got: %v", err)
+	s.Equal(1, itemErr.Index)
+	got, err := s.Repository.Get(one)
+	s.Require().NoError(err)
+	s.Equal("One", got.Title)
+
+	updated, err := s.Repository.BatchUpdate(patches, false)
+	s.Require().NoError(err)
+	s.NoError(updated[0].Err)
+	s.Equal("One, renamed", updated[0].Task.Title)
+	s.ErrorIs(updated[1].Err, repo.ErrConflict)
+
+	deleted, err := s.Repository.BatchDelete([]pb.ByIdReq{{Id: one}, {Id: two}, {Id: two}}, false)
+	s.Require().NoError(err)
+	s.NoError(deleted[0].Err)
+	s.NoError(deleted[1].Err)
+	s.ErrorIs(deleted[2].Err, repo.ErrNotFound)
+	s.Equal(two, deleted[2].Task.Id)
+}
+
+func (s *TaskStorageSuite) TestSearch() {
+	titleMatch, err := s.Repository.Create(pb.Task{Id: s.newID(), Assignee: s.assignee, Title: "Write release notes", Summary: "Mention the search", Status: "todo"})
+	s.Require().NoError(err)
+	summaryMatch, err := s.Repository.Create(pb.Task{Id: s.newID(), Assignee: s.assignee, Title: "Review budget", Summary: "Check release costs", Status: "todo"})
+	s.Require().NoError(err)
+	s.create("Water the plants", "")
+
+	got, err := s.Repository.Search(pb.SearchReq{Query: "release", Assignee: s.assignee, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(2), got.Count)
+	s.Require().Len(got.Results, 2)
+	s.Equal(titleMatch.Id, got.Results[0].Task.Id, "a title match outranks a summary match")
+	s.Equal("Write <b>release</b> notes", got.Results[0].TitleSnippet)
+	s.Contains(got.Results[1].SummarySnippet, "<b>release</b>")
+
+	first, err := s.Repository.Search(pb.SearchReq{Query: "release", Assignee: s.assignee, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(first.NextPageToken)
+	second, err := s.Repository.Search(pb.SearchReq{Query: "release", Assignee: s.assignee, Limit: 1, PageToken: first.NextPageToken})
+	s.Require().NoError(err)
+	s.Require().Len(second.Results, 1)
+	s.Equal(summaryMatch.Id, second.Results[0].Task.Id)
+	s.Empty(second.NextPageToken)
+
+	s.Require().NoError(s.Repository.Delete(titleMatch.Id, 0))
+	got, err = s.Repository.Search(pb.SearchReq{Query: "release", Assignee: s.assignee, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(1), got.Count)
+}
@@ -0,0 +1,189 @@
+package storagetest
+
+import (
+	"errors"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// WebhookStorageSuite checks a repo.WebhookStorageI. Every test subscribes to an event name
+// of its own and only looks at its own webhooks, so the storage may be shared:
+//
+//	suite.Run(t, &storagetest.WebhookStorageSuite{Repository: webhooks})
+type WebhookStorageSuite struct {
+	suite.Suite
+	Repository repo.WebhookStorageI
+
+	event string
+}
+
+func (s *WebhookStorageSuite) SetupTest() {
+	s.event = "test." + s.newID()[:8]
+}
+
+func (s *WebhookStorageSuite) newID() string {
+	id, err := uuid.NewV4()
+	s.Require().NoError(err)
+	return id.String()
+}
+
+func (s *WebhookStorageSuite) create(disabled bool) pb.Webhook {
+	hook, err := s.Repository.Create(pb.Webhook{
+		Id:       s.newID(),
+		Url:      "https://example.com/hooks",
+		Events:   []string{s.event, "task.deleted"},
+		Secret:   "s3cret",
+		Disabled: disabled,
+	})
+	s.Require().NoError(err)
+	s.T().Cleanup(func() {
+		if err := s.Repository.Delete(hook.Id); err != nil && !errors.Is(err, repo.ErrNotFound) {
+			s.T().Errorf("failed to clean up webhook %s: %v", hook.Id, err)
+		}
+	})
+	return hook
+}
+
+func (s *WebhookStorageSuite) TestCRUD() {
+	created := s.create(false)
+	s.Equal("https://example.com/hooks", created.Url)
+	s.Equal([]string{s.event, "task.deleted"}, created.Events)
+	s.Equal("s3cret", created.Secret)
+	s.NotNil(created.CreatedTime)
+	s.NotNil(created.UpdatedTime)
+
+	got, err := s.Repository.Get(created.Id)
+	s.Require().NoError(err)
+	s.Equal(created, got)
+
+	created.Url = "https://example.com/other"
+	created.Events = []string{s.event}
+	created.Disabled = true
+	created.Secret = ""
+	updated, err := s.Repository.Update(created)
+	s.Require().NoError(err)
+	s.Equal("https://example.com/other", updated.Url)
+	s.Equal([]string{s.event}, updated.Events)
+	s.True(updated.Disabled)
+	s.Equal("s3cret", updated.Secret, "an empty secret is kept")
+
+	updated.Secret = "rotated"
+	updated, err = s.Repository.Update(updated)
+	s.Require().NoError(err)
+	s.Equal("rotated", updated.Secret)
+
+	s.Require().NoError(s.Repository.Delete(created.Id))
+	_, err = s.Repository.Get(created.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+	s.ErrorIs(s.Repository.Delete(created.Id), repo.ErrNotFound)
+	_, err = s.Repository.Update(created)
+	s.ErrorIs(err, repo.ErrNotFound)
+}
+
+func (s *WebhookStorageSuite) TestCreateDuplicate() {
+	hook := s.create(false)
+	_, err := s.Repository.Create(hook)
+	s.ErrorIs(err, repo.ErrConflict)
+}
+
+func (s *WebhookStorageSuite) TestInvalidID() {
+	_, err := s.Repository.Get("not-a-uuid")
+	s.ErrorIs(err, repo.ErrInvalidArgument)
+}
+
+func (s *WebhookStorageSuite) TestList() {
+	first, second := s.create(false), s.create(true)
+
+	resp, err := s.Repository.List(pb.ListWebhooksReq{Page: 1, Limit: 1000})
+	s.Require().NoError(err)
+	s.GreaterOrEqual(resp.Count, int64(2))
+
+	var ids []string
+	for _, hook := range resp.Webhooks {
+		if hook.Id == first.Id || hook.Id == second.Id {
+			ids = append(ids, hook.Id)
+		}
+	}
+	s.Equal([]string{first.Id, second.Id}, ids, "oldest first")
+}
+
+func (s *WebhookStorageSuite) TestSubscribers() {
+	enabled := s.create(false)
+	s.create(true)
+
+	hooks, err := s.Repository.Subscribers(s.event)
+	s.Require().NoError(err)
+	s.Require().Len(hooks, 1, "disabled webhooks are left out")
+	s.Equal(enabled.Id, hooks[0].Id)
+	s.Equal("s3cret", hooks[0].Secret)
+
+	hooks, err = s.Repository.Subscribers(s.event + ".other")
+	s.Require().NoError(err)
+	s.Empty(hooks)
+}
+
+func (s *WebhookStorageSuite) TestDeliveries() {
+	hook := s.create(false)
+	eventID := s.newID()
+	for attempt := int32(1); attempt <= 3; attempt++ {
+		s.Require().NoError(s.Repository.RecordDelivery(pb.WebhookDelivery{
+			Id:         s.newID(),
+			WebhookId:  hook.Id,
+			EventId:    eventID,
+			Event:      s.event,
+			Attempt:    attempt,
+			StatusCode: 500,
+			Error:      "unexpected status 500",
+			Success:    attempt == 3,
+		}))
+	}
+
+	resp, err := s.Repository.ListDeliveries(pb.ListWebhookDeliveriesReq{WebhookId: hook.Id, Page: 1, Limit: 2})
+	s.Require().NoError(err)
+	s.Equal(int64(3), resp.Count)
+	s.Require().Len(resp.Deliveries, 2)
+	s.Equal(int32(3), resp.Deliveries[0].Attempt, "latest first")
+	s.True(resp.Deliveries[0].Success)
+	s.Equal(int32(2), resp.Deliveries[1].Attempt)
+	s.Equal(eventID, resp.Deliveries[1].EventId)
+	s.Equal(int32(500), resp.Deliveries[1].StatusCode)
+	s.NotNil(resp.Deliveries[1].CreatedTime)
+
+	err = s.Repository.RecordDelivery(pb.WebhookDelivery{Id: s.newID(), WebhookId: s.newID(), EventId: eventID, Event: s.event, Attempt: 1})
+	s.ErrorIs(err, repo.ErrInvalidArgument, "the webhook must exist")
+
+	s.Require().NoError(s.Repository.Delete(hook.Id))
+	resp, err = s.Repository.ListDeliveries(pb.ListWebhookDeliveriesReq{WebhookId: hook.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Zero(resp.Count, "deliveries go with their webhook")
+}
+
+func (s *WebhookStorageSuite) TestDeadLetters() {
+	hook := s.create(false)
+	letter := repo.DeadLetter{
+		ID:        s.newID(),
+		WebhookID: hook.Id,
+		EventID:   s.newID(),
+		Event:     s.event,
+		Payload:   []byte(`{"type": "task.created"}`),
+		Attempts:  5,
+		LastError: "connection refused",
+	}
+	s.Require().NoError(s.Repository.AddDeadLetter(letter))
+
+	letters, err := s.Repository.ListDeadLetters(hook.Id)
+	s.Require().NoError(err)
+	s.Require().Len(letters, 1)
+	s.Equal(letter.EventID, letters[0].EventID)
+	s.Equal(5, letters[0].Attempts)
+	s.Equal("connection refused", letters[0].LastError)
+	s.JSONEq(string(letter.Payload), string(letters[0].Payload))
+	s.False(letters[0].CreatedAt.IsZero())
+
+	letter.ID, letter.WebhookID = s.newID(), s.newID()
+	s.ErrorIs(s.Repository.AddDeadLetter(letter), repo.ErrInvalidArgument, "the webhook must exist")
+}
@@ -0,0 +1,174 @@
+package storagetest
+
+import (
+	"errors"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// WorkspaceStorageSuite checks a repo.WorkspaceStorageI together with the task repository
+// whose tasks it keeps apart. Every test names its workspaces after a prefix of its own,
+// so the storage may be shared:
+//
+//	suite.Run(t, &storagetest.WorkspaceStorageSuite{Workspaces: workspaces, Tasks: tasks})
+type WorkspaceStorageSuite struct {
+	suite.Suite
+	Workspaces repo.WorkspaceStorageI
+	Tasks      repo.TaskStorageI
+
+	prefix string
+}
+
+func (s *WorkspaceStorageSuite) SetupTest() {
+	s.prefix = "test-" + s.newID()[:8]
+}
+
+func (s *WorkspaceStorageSuite) newID() string {
+	id, err := uuid.NewV4()
+	s.Require().NoError(err)
+	return id.String()
+}
+
+// create stores a workspace named after the test, removed with its tasks when the test ends.
+func (s *WorkspaceStorageSuite) create(name string) pb.Workspace {
+	workspace, err := s.Workspaces.Create(pb.Workspace{Id: s.newID(), Name: s.prefix + "-" + name})
+	s.Require().NoError(err)
+	s.T().Cleanup(func() {
+		tasks := s.Tasks.InWorkspace(workspace.Id)
+		for _, list := range []func(pb.ListReq) (pb.ListResp, error){tasks.List, tasks.ListDeleted} {
+			resp, err := list(pb.ListReq{Page: 1, Limit: 100})
+			if err != nil {
+				s.T().Errorf("failed to list the tasks of workspace %s: %v", workspace.Id, err)
+				return
+			}
+			for _, task := range resp.Tasks {
+				if err := tasks.Delete(task.Id, 0); err != nil && !errors.Is(err, repo.ErrNotFound) {
+					s.T().Errorf("failed to clean up task %s: %v", task.Id, err)
+				}
+				if err := tasks.Purge(task.Id); err != nil {
+					s.T().Errorf("failed to clean up task %s: %v", task.Id, err)
+				}
+			}
+		}
+		if err := s.Workspaces.Delete(workspace.Id); err != nil && !errors.Is(err, repo.ErrNotFound) {
+			s.T().Errorf("failed to clean up workspace %s: %v", workspace.Id, err)
+		}
+	})
+	return workspace
+}
+
+func (s *WorkspaceStorageSuite) TestCRUD() {
+	created := s.create("crud")
+	s.Equal(s.prefix+"-crud", created.Name)
+	s.NotNil(created.CreatedTime)
+	s.NotNil(created.UpdatedTime)
+
+	got, err := s.Workspaces.Get(created.Id)
+	s.Require().NoError(err)
+	s.Equal(created, got)
+
+	updated, err := s.Workspaces.Update(pb.Workspace{Id: created.Id, Name: s.prefix + "-renamed"})
+	s.Require().NoError(err)
+	s.Equal(s.prefix+"-renamed", updated.Name)
+	s.Equal(created.CreatedTime, updated.CreatedTime)
+
+	_, err = s.Workspaces.Create(pb.Workspace{Id: s.newID(), Name: s.prefix + "-renamed"})
+	s.ErrorIs(err, repo.ErrConflict, "names are unique")
+	other := s.create("other")
+	_, err = s.Workspaces.Update(pb.Workspace{Id: other.Id, Name: s.prefix + "-renamed"})
+	s.ErrorIs(err, repo.ErrConflict)
+
+	s.Require().NoError(s.Workspaces.Delete(created.Id))
+	_, err = s.Workspaces.Get(created.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+	s.ErrorIs(s.Workspaces.Delete(created.Id), repo.ErrNotFound)
+	_, err = s.Workspaces.Update(pb.Workspace{Id: created.Id, Name: s.prefix + "-gone"})
+	s.ErrorIs(err, repo.ErrNotFound)
+}
+
+func (s *WorkspaceStorageSuite) TestDefault() {
+	workspace, err := s.Workspaces.Get(repo.DefaultWorkspaceID)
+	s.Require().NoError(err)
+	s.Equal(repo.DefaultWorkspaceID, workspace.Id)
+
+	task, err := s.Tasks.Create(pb.Task{Id: s.newID(), Assignee: s.prefix, Title: "No workspace", Status: "todo"})
+	s.Require().NoError(err)
+	s.Equal(repo.DefaultWorkspaceID, task.WorkspaceId)
+	s.Require().NoError(s.Tasks.Delete(task.Id, 0))
+	s.Require().NoError(s.Tasks.Purge(task.Id))
+}
+
+func (s *WorkspaceStorageSuite) TestList() {
+	first, second := s.create("first"), s.create("second")
+
+	got, err := s.Workspaces.List(pb.ListWorkspacesReq{Page: 1, Limit: 1000})
+	s.Require().NoError(err)
+	s.GreaterOrEqual(got.Count, int64(3))
+	var ids []string
+	for _, workspace := range got.Workspaces {
+		ids = append(ids, workspace.Id)
+	}
+	s.Subset(ids, []string{repo.DefaultWorkspaceID, first.Id, second.Id})
+}
+
+func (s *WorkspaceStorageSuite) TestIsolation() {
+	ours, theirs := s.create("ours"), s.create("theirs")
+	inOurs, inTheirs := s.Tasks.InWorkspace(ours.Id), s.Tasks.InWorkspace(theirs.Id)
+
+	// the workspace of the repository wins over the one the task names
+	task, err := inOurs.Create(pb.Task{Id: s.newID(), WorkspaceId: theirs.Id, Assignee: s.prefix, Title: "Ours", Deadline: "1990-01-01", Status: "todo"})
+	s.Require().NoError(err)
+	s.Equal(ours.Id, task.WorkspaceId)
+
+	got, err := s.Tasks.Get(task.Id)
+	s.Require().NoError(err)
+	s.Equal(ours.Id, got.WorkspaceId)
+	_, err = inTheirs.Get(task.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+
+	list, err := inTheirs.List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.prefix})
+	s.Require().NoError(err)
+	s.Zero(list.Count)
+	list, err = inOurs.List(pb.ListReq{Page: 1, Limit: 10, Assignee: s.prefix})
+	s.Require().NoError(err)
+	s.Equal(int64(1), list.Count)
+	overdue, err := inTheirs.ListOverdue(pb.ByDeadlineReq{Deadline: "2000-01-01", Page: 1, Limit: 1000})
+	s.Require().NoError(err)
+	s.Zero(overdue.Count)
+	found, err := inTheirs.Search(pb.SearchReq{Query: "ours", Assignee: s.prefix, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Zero(found.Count)
+	many, err := inTheirs.GetMany([]string{task.Id})
+	s.Require().NoError(err)
+	s.Empty(many)
+	history, err := inTheirs.History(pb.TaskHistoryReq{TaskId: task.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Zero(history.Count)
+
+	_, err = inTheirs.Patch(pb.Task{Id: task.Id, Title: "Theirs"}, []string{"title"})
+	s.ErrorIs(err, repo.ErrNotFound)
+	_, err = inTheirs.ChangeStatus(task.Id, "todo", "done")
+	s.ErrorIs(err, repo.ErrNotFound)
+	s.ErrorIs(inTheirs.Delete(task.Id, 0), repo.ErrNotFound)
+	s.Require().NoError(inOurs.Delete(task.Id, 0))
+	_, err = inTheirs.Restore(task.Id)
+	s.ErrorIs(err, repo.ErrNotFound)
+	s.ErrorIs(inTheirs.Purge(task.Id), repo.ErrNotFound)
+
+	s.ErrorIs(s.Workspaces.Delete(ours.Id), repo.ErrInUse, "a deleted task still belongs to its workspace")
+	s.Require().NoError(inOurs.Purge(task.Id))
+	history, err = inOurs.History(pb.TaskHistoryReq{TaskId: task.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Equal(int64(2), history.Count, "the history of a purged task stays in its workspace")
+	history, err = inTheirs.History(pb.TaskHistoryReq{TaskId: task.Id, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Zero(history.Count)
+	s.Require().NoError(s.Workspaces.Delete(ours.Id))
+
+	_, err = s.Tasks.Create(pb.Task{Id: s.newID(), WorkspaceId: ours.Id, Assignee: s.prefix, Title: "Nowhere", Status: "todo"})
+	s.ErrorIs(err, repo.ErrInvalidArgument, "tasks are only created in workspaces that exist")
+}
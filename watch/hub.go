@@ -0,0 +1,237 @@
+// Package watch streams task events to clients as the outbox relay publishes them. A Hub
+// reads the events the relay has delivered and hands them to every watcher; a watcher
+// that falls behind, or resumes from an earlier revision, catches up from storage.
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+)
+
+// Defaults for what Options leave unset.
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultBatchSize    = 100
+	defaultBuffer       = 256
+)
+
+var (
+	// ErrFutureRevision is returned by Watch for a revision no event has yet.
+	ErrFutureRevision = errors.New("revision is ahead of the last published event")
+	// ErrStopped is returned by Watch once the hub stopped running.
+	ErrStopped = errors.New("watch hub stopped")
+)
+
+// Options configure a Hub.
+type Options struct {
+	// PollInterval is how often the hub looks for new events without being woken.
+	PollInterval time.Duration
+	// BatchSize is how many events are read from storage at a time.
+	BatchSize int
+	// Buffer is how many events may wait for a slow watcher before it has to catch up
+	// from storage.
+	Buffer int
+}
+
+// Hub hands the events the relay delivers to the watchers.
+type Hub struct {
+	outbox repo.OutboxStorageI
+	logger l.Logger
+	opts   Options
+	wake   chan struct{}
+	done   chan struct{}
+
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+}
+
+type watcher struct {
+	events chan repo.Event
+	// behind is signalled when an event could not be buffered.
+	behind chan struct{}
+}
+
+// NewHub ...
+func NewHub(outbox repo.OutboxStorageI, log l.Logger, opts Options) *Hub {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	if opts.Buffer <= 0 {
+		opts.Buffer = defaultBuffer
+	}
+
+	return &Hub{
+		outbox:   outbox,
+		logger:   log,
+		opts:     opts,
+		wake:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		watchers: map[*watcher]struct{}{},
+	}
+}
+
+// Wake tells the hub that events may have been delivered. It never blocks.
+func (h *Hub) Wake() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run hands new events to the watchers whenever the hub is woken, and every PollInterval,
+// until ctx is done. The watches end then too. Run is called once.
+func (h *Hub) Run(ctx context.Context) {
+	defer close(h.done)
+
+	ticker := time.NewTicker(h.opts.PollInterval)
+	defer ticker.Stop()
+
+	last, err := h.outbox.LastRevision()
+	for err != nil {
+		h.logger.Error("failed to read the last event revision", l.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		last, err = h.outbox.LastRevision()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.wake:
+		case <-ticker.C:
+		}
+
+		for {
+			events, err := h.outbox.Since(last, h.opts.BatchSize)
+			if err != nil {
+				h.logger.Error("failed to read events for watchers", l.Error(err))
+				break
+			}
+			for _, e := range events {
+				h.broadcast(e)
+				last = e.Revision
+			}
+			if len(events) < h.opts.BatchSize {
+				break
+			}
+		}
+	}
+}
+
+func (h *Hub) broadcast(e repo.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for w := range h.watchers {
+		select {
+		case w.events <- e:
+		default:
+			select {
+			case w.behind <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+func (h *Hub) subscribe() *watcher {
+	w := &watcher{events: make(chan repo.Event, h.opts.Buffer), behind: make(chan struct{}, 1)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.watchers[w] = struct{}{}
+	return w
+}
+
+func (h *Hub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.watchers, w)
+}
+
+// Watch calls send with every event after revision from that match accepts, in revision
+// order, until ctx is done or send fails. A from of 0 starts with the next event. It
+// returns ErrFutureRevision when from is ahead of the last event, and ErrStopped when the
+// hub stops.
+func (h *Hub) Watch(ctx context.Context, from int64, match func(repo.Event) bool, send func(repo.Event) error) error {
+	last, err := h.outbox.LastRevision()
+	if err != nil {
+		return err
+	}
+	if from > last {
+		return ErrFutureRevision
+	}
+	if from > 0 {
+		last = from
+	}
+
+	// Events delivered before the subscription are caught up with from storage.
+	w := h.subscribe()
+	defer h.unsubscribe(w)
+
+	for {
+		if last, err = h.catchUp(last, match, send); err != nil {
+			return err
+		}
+
+	live:
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-h.done:
+				return ErrStopped
+			case <-w.behind:
+				break live
+			case e := <-w.events:
+				if e.Revision <= last {
+					continue // already sent while catching up
+				}
+				if e.Revision != last+1 {
+					break live // missed some
+				}
+				last = e.Revision
+				if match(e) {
+					if err := send(e); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+}
+
+// catchUp sends the events after last from storage and returns the revision of the last one.
+func (h *Hub) catchUp(last int64, match func(repo.Event) bool, send func(repo.Event) error) (int64, error) {
+	for {
+		events, err := h.outbox.Since(last, h.opts.BatchSize)
+		if err != nil {
+			return last, err
+		}
+		for _, e := range events {
+			last = e.Revision
+			if match(e) {
+				if err := send(e); err != nil {
+					return last, err
+				}
+			}
+		}
+		if len(events) < h.opts.BatchSize {
+			return last, nil
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/outbox"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/memory"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+)
+
+// env is a hub over in-memory storage whose events are relayed on demand.
+type env struct {
+	tasks repo.TaskStorageI
+	hub   *Hub
+	relay *outbox.Relay
+}
+
+func newEnv(t *testing.T, opts Options) *env {
+	t.Helper()
+
+	tasks := memory.NewTaskRepo()
+	store := memory.NewOutboxRepo(tasks)
+	hub := NewHub(store, l.New("error", "test"), opts)
+	relay := outbox.NewRelay(store, outbox.NewMemoryPublisher(), l.New("error", "test"), outbox.Options{BatchSize: 1000, Relayed: hub.Wake})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		hub.Run(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return &env{tasks: tasks, hub: hub, relay: relay}
+}
+
+// create stores tasks with the given titles, assigned to assignee, and relays their events.
+func (e *env) create(t *testing.T, assignee string, titles ...string) {
+	t.Helper()
+
+	for _, title := range titles {
+		_, err := e.tasks.Create(pb.Task{Id: uuid.Must(uuid.NewV4()).String(), Assignee: assignee, Title: title, Status: "todo"})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+	}
+	if _, err := e.relay.Flush(context.Background()); err != nil {
+		t.Fatalf("failed to relay: %v", err)
+	}
+}
+
+// stream is a running Watch and what it sent so far.
+type stream struct {
+	mu     sync.Mutex
+	events []repo.Event
+	done   chan error
+	cancel context.CancelFunc
+}
+
+func (e *env) watch(from int64, match func(repo.Event) bool) *stream {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &stream{done: make(chan error, 1), cancel: cancel}
+	go func() {
+		s.done <- e.hub.Watch(ctx, from, match, func(ev repo.Event) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.events = append(s.events, ev)
+			return nil
+		})
+	}()
+	return s
+}
+
+// wait returns "revision title" strings for the first n events sent, failing when they
+// don't come.
+func (s *stream) wait(t *testing.T, n int) []string {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mu.Lock()
+		events := append([]repo.Event(nil), s.events...)
+		s.mu.Unlock()
+
+		if len(events) >= n {
+			got := []string{}
+			for _, e := range events {
+				got = append(got, fmt.Sprintf("%d %s", e.Revision, e.Task.Title))
+			}
+			return got
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d events, want %d", len(events), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func all(repo.Event) bool { return true }
+
+// subscribed waits until the hub has n watchers, so events created next are live ones.
+func (e *env) subscribed(t *testing.T, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		e.hub.mu.Lock()
+		got := len(e.hub.watchers)
+		e.hub.mu.Unlock()
+		if got == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d watchers, want %d", got, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHub_Watch(t *testing.T) {
+	e := newEnv(t, Options{})
+	e.create(t, "lola", "Old")
+
+	live := e.watch(0, all)
+	mine := e.watch(0, func(ev repo.Event) bool { return ev.Task.Assignee == "lola" })
+	resumed := e.watch(1, all)
+	canceled := e.watch(0, all)
+	canceled.cancel()
+	if err := <-canceled.done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Watch() after cancel = %v, want %v", err, context.Canceled)
+	}
+	e.subscribed(t, 3)
+
+	e.create(t, "lola", "One")
+	e.create(t, "bob", "Two")
+	e.create(t, "lola", "Three")
+
+	tests := []struct {
+		name   string
+		stream *stream
+		want   []string
+	}{
+		{name: "from the next event", stream: live, want: []string{"2 One", "3 Two", "4 Three"}},
+		{name: "filtered", stream: mine, want: []string{"2 One", "4 Three"}},
+		{name: "resumed", stream: resumed, want: []string{"2 One", "3 Two", "4 Three"}},
+	}
+	for _, tt := range tests {
+		if got := tt.stream.wait(t, len(tt.want)); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// A watch started later replays from storage.
+	late := e.watch(1, all)
+	if got := late.wait(t, 3); strings.Join(got, ",") != "2 One,3 Two,4 Three" {
+		t.Errorf("late: got %v, want the events after revision 1", got)
+	}
+}
+
+func TestHub_SlowWatcher(t *testing.T) {
+	e := newEnv(t, Options{Buffer: 1})
+
+	release := make(chan struct{})
+	var (
+		mu  sync.Mutex
+		got []string
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = e.hub.Watch(ctx, 0, all, func(ev repo.Event) error {
+			<-release
+			mu.Lock()
+			defer mu.Unlock()
+			got = append(got, ev.Task.Title)
+			return nil
+		})
+	}()
+	e.subscribed(t, 1)
+
+	e.create(t, "", "One", "Two", "Three", "Four", "Five")
+	close(release)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mu.Lock()
+		titles := strings.Join(got, ",")
+		mu.Unlock()
+		if titles == "One,Two,Three,Four,Five" {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %s, want every event once and in order", titles)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHub_WatchErrors(t *testing.T) {
+	e := newEnv(t, Options{})
+	e.create(t, "", "One")
+
+	if err := e.hub.Watch(context.Background(), 2, all, func(repo.Event) error { return nil }); err != ErrFutureRevision {
+		t.Errorf("Watch() from a future revision = %v, want %v", err, ErrFutureRevision)
+	}
+
+	failed := errors.New("client went away")
+	done := make(chan error, 1)
+	go func() {
+		done <- e.hub.Watch(context.Background(), 0, all, func(repo.Event) error { return failed })
+	}()
+	e.subscribed(t, 1)
+	e.create(t, "", "Two")
+	if err := <-done; err != failed {
+		t.Errorf("Watch() with a failing send = %v, want %v", err, failed)
+	}
+}
+
+func TestHub_Stop(t *testing.T) {
+	tasks := memory.NewTaskRepo()
+	hub := NewHub(memory.NewOutboxRepo(tasks), l.New("error", "test"), Options{})
+	ctx, cancel := context.WithCancel(context.Background())
+	go hub.Run(ctx)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- hub.Watch(context.Background(), 0, all, func(repo.Event) error { return nil })
+	}()
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != ErrStopped {
+			t.Errorf("Watch() = %v, want %v", err, ErrStopped)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Watch() kept running after the hub stopped")
+	}
+}
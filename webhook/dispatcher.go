@@ -0,0 +1,232 @@
+// Package webhook tells other services about task events: a Dispatcher POSTs every event
+// as signed JSON to the webhooks subscribed to it, retrying failed deliveries with
+// exponential backoff and keeping the ones that never succeed as dead letters.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/outbox"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+)
+
+// Defaults for what Options leave unset.
+const (
+	defaultMaxAttempts = 5
+	defaultBaseDelay   = time.Second
+	defaultMaxDelay    = 5 * time.Minute
+	defaultTimeout     = 10 * time.Second
+)
+
+// Options configure a Dispatcher.
+type Options struct {
+	// MaxAttempts is how often a delivery is tried before it becomes a dead letter.
+	MaxAttempts int
+	// BaseDelay is the wait before the first retry; it doubles with every further one.
+	BaseDelay time.Duration
+	// MaxDelay caps the wait between retries.
+	MaxDelay time.Duration
+	// Client sends the requests, one that gives up after 10 seconds when nil.
+	Client *http.Client
+	// Now reads the clock, time.Now when nil.
+	Now func() time.Time
+}
+
+// Dispatcher is an outbox.EventPublisher that delivers every event published to it before
+// Publish returns, so the relay marks an event delivered only once each subscribed webhook
+// got it or kept it as a dead letter. Every attempt is recorded in the delivery history
+// of its webhook.
+type Dispatcher struct {
+	webhooks repo.WebhookStorageI
+	logger   l.Logger
+	opts     Options
+}
+
+// NewDispatcher ...
+func NewDispatcher(webhooks repo.WebhookStorageI, log l.Logger, opts Options) *Dispatcher {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.BaseDelay <= 0 {
+		opts.BaseDelay = defaultBaseDelay
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = defaultMaxDelay
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: defaultTimeout}
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	return &Dispatcher{
+		webhooks: webhooks,
+		logger:   log,
+		opts:     opts,
+	}
+}
+
+// Publish dispatches e and fails when it couldn't, so the event stays pending in the
+// outbox and is published again, to every subscriber: receivers tell repeats apart by
+// HeaderDelivery.
+func (d *Dispatcher) Publish(ctx context.Context, e repo.Event) error {
+	return d.Dispatch(ctx, e)
+}
+
+// Dispatch delivers e to every webhook subscribed to it, side by side, and returns once
+// each delivery has succeeded or become a dead letter. Failed deliveries are not errors;
+// the error is about reading the subscribers, or about deliveries ctx cut short.
+func (d *Dispatcher) Dispatch(ctx context.Context, e repo.Event) error {
+	hooks, err := d.webhooks.Subscribers(string(e.Type))
+	if err != nil || len(hooks) == 0 {
+		return err
+	}
+
+	body, err := outbox.Encode(e)
+	if err != nil {
+		return err
+	}
+
+	errs := make(chan error, len(hooks))
+	for _, hook := range hooks {
+		go func(hook pb.Webhook) {
+			errs <- d.deliver(ctx, hook, e, body)
+		}(hook)
+	}
+	var first error
+	for range hooks {
+		if err := <-errs; err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// deliver tries to deliver body to hook until it succeeds or MaxAttempts run out, and
+// keeps it as a dead letter then. One that is cut short by ctx, or whose dead letter
+// can't be stored, fails instead, for the event to be published again.
+func (d *Dispatcher) deliver(ctx context.Context, hook pb.Webhook, e repo.Event, body []byte) error {
+	var (
+		attempt int
+		lastErr error
+	)
+	for attempt = 1; ; attempt++ {
+		code, err := d.attempt(ctx, hook, e, body)
+		d.record(hook, e, attempt, code, err)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if ctx.Err() == nil && attempt == d.opts.MaxAttempts {
+			break
+		}
+		if ctx.Err() != nil || !wait(ctx, d.backoff(attempt)) {
+			return fmt.Errorf("delivery to webhook %s cut short after %d attempts: %w", hook.Id, attempt, ctx.Err())
+		}
+	}
+
+	d.logger.Warn("webhook delivery failed, keeping it as a dead letter",
+		l.String("webhook_id", hook.Id), l.String("event_id", e.ID), l.Int("attempts", attempt), l.Error(lastErr))
+	err := d.webhooks.AddDeadLetter(repo.DeadLetter{
+		ID:        uuid.Must(uuid.NewV4()).String(),
+		WebhookID: hook.Id,
+		EventID:   e.ID,
+		Event:     string(e.Type),
+		Payload:   body,
+		Attempts:  attempt,
+		LastError: lastErr.Error(),
+	})
+	if err != nil {
+		return fmt.Errorf("store dead letter of webhook %s: %w", hook.Id, err)
+	}
+	return nil
+}
+
+// attempt POSTs body to hook once and returns the response status, 0 without a response.
+// Any answer but a 2xx is a failure.
+func (d *Dispatcher) attempt(ctx context.Context, hook pb.Webhook, e repo.Event, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := d.opts.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(e.Type))
+	req.Header.Set(HeaderDelivery, e.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(hook.Secret, timestamp, body))
+
+	resp, err := d.opts.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	// Drain what is left of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// record adds an attempt to the delivery history of hook. The delivery goes on when that
+// fails; the history is only for people looking into it.
+func (d *Dispatcher) record(hook pb.Webhook, e repo.Event, attempt, code int, err error) {
+	delivery := pb.WebhookDelivery{
+		Id:         uuid.Must(uuid.NewV4()).String(),
+		WebhookId:  hook.Id,
+		EventId:    e.ID,
+		Event:      string(e.Type),
+		Attempt:    int32(attempt),
+		StatusCode: int32(code),
+		Success:    err == nil,
+	}
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+
+	if err := d.webhooks.RecordDelivery(delivery); err != nil {
+		d.logger.Error("failed to record webhook delivery",
+			l.String("webhook_id", hook.Id), l.String("event_id", e.ID), l.Error(err))
+	}
+}
+
+// wait sleeps for delay and reports false when ctx is done first.
+func wait(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// backoff is the wait after the given failed attempt: BaseDelay doubled for every
+// attempt before it, at most MaxDelay.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.opts.BaseDelay
+	for i := 1; i < attempt && delay < d.opts.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > d.opts.MaxDelay {
+		delay = d.opts.MaxDelay
+	}
+	return delay
+}
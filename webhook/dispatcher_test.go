@@ -0,0 +1,309 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/NafisaTojiboyeva/todo-service/genproto"
+	"github.com/NafisaTojiboyeva/todo-service/outbox"
+	l "github.com/NafisaTojiboyeva/todo-service/pkg/logger"
+	"github.com/NafisaTojiboyeva/todo-service/storage/memory"
+	"github.com/NafisaTojiboyeva/todo-service/storage/repo"
+
+	"github.com/gofrs/uuid"
+)
+
+const secret = "s3cret"
+
+// receiver is a webhook endpoint that answers with the statuses it is given in turn, the
+// last one from then on, and remembers the requests it got.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []received
+}
+
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.requests = append(rc.requests, received{header: r.Header, body: body})
+	status := rc.statuses[0]
+	if len(rc.statuses) > 1 {
+		rc.statuses = rc.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (rc *receiver) got() []received {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	return append([]received(nil), rc.requests...)
+}
+
+func newWebhook(t *testing.T, webhooks repo.WebhookStorageI, url string, disabled bool, events ...repo.EventType) pb.Webhook {
+	t.Helper()
+
+	hook := pb.Webhook{Id: uuid.Must(uuid.NewV4()).String(), Url: url, Secret: secret, Disabled: disabled}
+	for _, e := range events {
+		hook.Events = append(hook.Events, string(e))
+	}
+	hook, err := webhooks.Create(hook)
+	if err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+	return hook
+}
+
+func newEvent(t repo.EventType, task pb.Task) repo.Event {
+	return repo.Event{ID: uuid.Must(uuid.NewV4()).String(), Type: t, Task: task, OccurredAt: time.Now()}
+}
+
+func deliveries(t *testing.T, webhooks repo.WebhookStorageI, hook pb.Webhook) []*pb.WebhookDelivery {
+	t.Helper()
+
+	resp, err := webhooks.ListDeliveries(pb.ListWebhookDeliveriesReq{WebhookId: hook.Id, Page: 1, Limit: 100})
+	if err != nil {
+		t.Fatalf("failed to list deliveries: %v", err)
+	}
+	return resp.Deliveries
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	now := time.Date(2021, 12, 22, 10, 0, 0, 0, time.UTC)
+	task := pb.Task{Id: "24465fe0-9ea1-45ce-8a7a-79c63972efe9", Assignee: "Lola", Title: "Test", Status: "done"}
+	event := repo.Event{ID: "0a1f5a56-7b5e-4b53-9d55-4d3e0cf3c2a1", Type: repo.EventTaskCompleted, Task: task, OccurredAt: now}
+
+	tests := []struct {
+		name        string
+		statuses    []int
+		wantCodes   []int32 // of the recorded attempts, latest first
+		wantLetters int
+	}{
+		{name: "delivered", statuses: []int{http.StatusNoContent}, wantCodes: []int32{204}},
+		{name: "retried", statuses: []int{500, 503, 200}, wantCodes: []int32{200, 503, 500}},
+		{name: "dead letter", statuses: []int{500}, wantCodes: []int32{500, 500, 500}, wantLetters: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := &receiver{statuses: tt.statuses}
+			srv := httptest.NewServer(rc)
+			defer srv.Close()
+
+			webhooks := memory.NewWebhookRepo()
+			hook := newWebhook(t, webhooks, srv.URL, false, repo.EventTaskCompleted)
+			other := newWebhook(t, webhooks, srv.URL, false, repo.EventTaskCreated)
+			disabled := newWebhook(t, webhooks, srv.URL, true, repo.EventTaskCompleted)
+
+			d := NewDispatcher(webhooks, l.New("error", "test"), Options{
+				MaxAttempts: 3,
+				BaseDelay:   time.Millisecond,
+				Now:         func() time.Time { return now },
+			})
+			if err := d.Dispatch(context.Background(), event); err != nil {
+				t.Fatalf("Dispatch() error = %v", err)
+			}
+
+			requests := rc.got()
+			if len(requests) != len(tt.wantCodes) {
+				t.Fatalf("got %d requests, want %d", len(requests), len(tt.wantCodes))
+			}
+			req := requests[0]
+			if got := req.header.Get(HeaderEvent); got != string(repo.EventTaskCompleted) {
+				t.Errorf("%s = %q, want %q", HeaderEvent, got, repo.EventTaskCompleted)
+			}
+			if got := req.header.Get(HeaderDelivery); got != event.ID {
+				t.Errorf("%s = %q, want %q", HeaderDelivery, got, event.ID)
+			}
+			timestamp, _ := strconv.ParseInt(req.header.Get(HeaderTimestamp), 10, 64)
+			if timestamp != now.Unix() {
+				t.Errorf("%s = %d, want %d", HeaderTimestamp, timestamp, now.Unix())
+			}
+			if !Verify(secret, req.header.Get(HeaderSignature), timestamp, req.body) {
+				t.Errorf("signature %q doesn't verify", req.header.Get(HeaderSignature))
+			}
+
+			var body struct {
+				outbox.Payload
+				Task struct {
+					ID     string `json:"id"`
+					Title  string `json:"Title"`
+					Status string `json:"Status"`
+				} `json:"task"`
+			}
+			if err := json.Unmarshal(req.body, &body); err != nil {
+				t.Fatalf("body is not JSON: %v", err)
+			}
+			if body.ID != event.ID || body.Type != repo.EventTaskCompleted || !body.OccurredAt.Equal(now) {
+				t.Errorf("payload = %+v, want the event", body.Payload)
+			}
+			if body.Task.ID != task.Id || body.Task.Title != task.Title || body.Task.Status != task.Status {
+				t.Errorf("payload task = %+v, want %+v", body.Task, task)
+			}
+
+			var codes []int32
+			for i, delivery := range deliveries(t, webhooks, hook) {
+				codes = append(codes, delivery.StatusCode)
+				if delivery.EventId != event.ID || delivery.Event != string(repo.EventTaskCompleted) {
+					t.Errorf("delivery %d = %+v, want one of the event", i, delivery)
+				}
+				if delivery.Success != (delivery.StatusCode < 300) {
+					t.Errorf("delivery %d success = %v with status %d", i, delivery.Success, delivery.StatusCode)
+				}
+			}
+			if !equalCodes(codes, tt.wantCodes) {
+				t.Errorf("recorded status codes = %v, want %v", codes, tt.wantCodes)
+			}
+
+			letters, err := webhooks.ListDeadLetters(hook.Id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(letters) != tt.wantLetters {
+				t.Fatalf("got %d dead letters, want %d", len(letters), tt.wantLetters)
+			}
+			if tt.wantLetters > 0 {
+				if letters[0].Attempts != 3 || letters[0].EventID != event.ID || string(letters[0].Payload) != string(req.body) {
+					t.Errorf("dead letter = %+v, want the event after 3 attempts", letters[0])
+				}
+			}
+
+			for _, skipped := range []pb.Webhook{other, disabled} {
+				if got := deliveries(t, webhooks, skipped); len(got) > 0 {
+					t.Errorf("webhook %s got %d deliveries, want none", skipped.Id, len(got))
+				}
+			}
+		})
+	}
+}
+
+func equalCodes(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDispatcher_Publish(t *testing.T) {
+	rc := &receiver{statuses: []int{http.StatusOK}}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	webhooks := memory.NewWebhookRepo()
+	hook := newWebhook(t, webhooks, srv.URL, false, repo.EventTaskCreated, repo.EventTaskDeleted)
+	d := NewDispatcher(webhooks, l.New("error", "test"), Options{})
+
+	for _, e := range []repo.EventType{repo.EventTaskCreated, repo.EventTaskUpdated, repo.EventTaskDeleted} {
+		if err := d.Publish(context.Background(), newEvent(e, pb.Task{Id: uuid.Must(uuid.NewV4()).String()})); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	// Publish returns once the events are delivered, not once they are queued.
+	if got := len(rc.got()); got != 2 {
+		t.Errorf("got %d requests, want 2 for the subscribed events", got)
+	}
+	if got := len(deliveries(t, webhooks, hook)); got != 2 {
+		t.Errorf("got %d recorded deliveries, want 2", got)
+	}
+}
+
+func TestDispatcher_GivesUpOnShutdown(t *testing.T) {
+	rc := &receiver{statuses: []int{http.StatusBadGateway}}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	webhooks := memory.NewWebhookRepo()
+	hook := newWebhook(t, webhooks, srv.URL, false, repo.EventTaskUpdated)
+	d := NewDispatcher(webhooks, l.New("error", "test"), Options{BaseDelay: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for len(rc.got()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+	if err := d.Publish(ctx, newEvent(repo.EventTaskUpdated, pb.Task{})); err == nil {
+		t.Fatal("Publish() cut short by shutdown error = nil, want the event to stay pending")
+	}
+
+	letters, err := webhooks.ListDeadLetters(hook.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(letters) != 0 {
+		t.Errorf("dead letters = %+v, want none for a delivery that is published again", letters)
+	}
+	if got := len(deliveries(t, webhooks, hook)); got != 1 {
+		t.Errorf("got %d recorded deliveries, want the first attempt", got)
+	}
+}
+
+func TestDispatcher_backoff(t *testing.T) {
+	d := NewDispatcher(nil, l.New("error", "test"), Options{BaseDelay: time.Second, MaxDelay: 10 * time.Second})
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: time.Second},
+		{attempt: 2, want: 2 * time.Second},
+		{attempt: 3, want: 4 * time.Second},
+		{attempt: 4, want: 8 * time.Second},
+		{attempt: 5, want: 10 * time.Second},
+		{attempt: 60, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := d.backoff(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	signature := Sign(secret, 1640167200, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		signature string
+		timestamp int64
+		body      []byte
+		want      bool
+	}{
+		{name: "valid", secret: secret, signature: signature, timestamp: 1640167200, body: body, want: true},
+		{name: "other secret", secret: "other", signature: signature, timestamp: 1640167200, body: body},
+		{name: "other timestamp", secret: secret, signature: signature, timestamp: 1640167201, body: body},
+		{name: "other body", secret: secret, signature: signature, timestamp: 1640167200, body: []byte(`{"id":"2"}`)},
+		{name: "no prefix", secret: secret, signature: signature[len("sha256="):], timestamp: 1640167200, body: body},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.signature, tt.timestamp, tt.body); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
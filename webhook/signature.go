@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Headers of a webhook request.
+const (
+	// HeaderEvent carries the repo.EventType.
+	HeaderEvent = "X-Todo-Event"
+	// HeaderDelivery carries the event id, the same for every attempt, so receivers can
+	// drop duplicates.
+	HeaderDelivery = "X-Todo-Delivery"
+	// HeaderTimestamp carries the Unix time the attempt was signed at.
+	HeaderTimestamp = "X-Todo-Timestamp"
+	// HeaderSignature carries Sign of the timestamp and body.
+	HeaderSignature = "X-Todo-Signature"
+)
+
+// signaturePrefix names the algorithm of a signature.
+const signaturePrefix = "sha256="
+
+// Sign returns the signature of a request sent at timestamp with body: sha256= and the hex
+// HMAC-SHA256, keyed with secret, of the timestamp, a dot and the body. Signing the
+// timestamp lets receivers reject old requests replayed to them.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10))) // nolint:errcheck
+	mac.Write([]byte("."))                              // nolint:errcheck
+	mac.Write(body)                                     // nolint:errcheck
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify tells whether signature is Sign of timestamp and body, in constant time.
+func Verify(secret, signature string, timestamp int64, body []byte) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body)))
+}